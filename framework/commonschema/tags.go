@@ -7,11 +7,15 @@ import (
 	"context"
 
 	"github.com/hashicorp/go-azure-helpers/framework/convert"
+	"github.com/hashicorp/go-azure-helpers/framework/planmodifiers"
 	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -61,3 +65,36 @@ func FlattenTags(ctx context.Context, tags *map[string]string, diags *diag.Diagn
 
 	return result
 }
+
+// TagsAllResourceAttribute returns the schema for a `tags_all` attribute, which contains the Tags specified in
+// the `tags` attribute merged with the default Tags returned from `defaults`
+func TagsAllResourceAttribute(ctx context.Context, defaults func() map[string]string) resourceschema.MapAttribute {
+	return resourceschema.MapAttribute{
+		CustomType:          typehelpers.NewMapTypeOf[types.String](ctx),
+		ElementType:         types.StringType,
+		Computed:            true,
+		Description:         "A map of tags assigned to the resource, including the default tags",
+		MarkdownDescription: "A map of tags assigned to the resource, including the default tags",
+		PlanModifiers: []planmodifier.Map{
+			planmodifiers.TagsAllPlanModifier(path.Root("tags"), defaults),
+		},
+	}
+}
+
+// FlattenTagsWithConfiguredCasing flattens the Tags returned from the API, using the casing of the keys in the
+// `configured` Tags for any keys which only differ by casing - since Azure treats Tag keys case-insensitively
+func FlattenTagsWithConfiguredCasing(ctx context.Context, configured typehelpers.MapValueOf[types.String], input *map[string]string, diags *diag.Diagnostics) typehelpers.MapValueOf[types.String] {
+	if input == nil {
+		return FlattenTags(ctx, input, diags)
+	}
+
+	existing := make(map[string]string)
+	for k, v := range configured.Elements() {
+		if value, ok := v.(types.String); ok && !value.IsNull() && !value.IsUnknown() {
+			existing[k] = value.ValueString()
+		}
+	}
+
+	normalized := tags.NormalizeKeyCasing(existing, *input)
+	return FlattenTags(ctx, &normalized, diags)
+}
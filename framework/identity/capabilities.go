@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type capabilitiesValidator struct {
+	capabilities identity.Capabilities
+}
+
+var _ validator.List = &capabilitiesValidator{}
+
+// ValidateCapabilities validates that the `type` and `identity_ids` specified within an `identity` block
+// are supported by the provided Capabilities, raising a diagnostic against the offending attribute
+func ValidateCapabilities(capabilities identity.Capabilities) validator.List {
+	return capabilitiesValidator{
+		capabilities: capabilities,
+	}
+}
+
+func (c capabilitiesValidator) Description(_ context.Context) string {
+	return "validates that the Identity Type and User Assigned Identities are supported by this resource"
+}
+
+func (c capabilitiesValidator) MarkdownDescription(ctx context.Context) string {
+	return c.Description(ctx)
+}
+
+func (c capabilitiesValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range request.ConfigValue.Elements() {
+		objectValuable, ok := element.(basetypes.ObjectValuable)
+		if !ok {
+			continue
+		}
+		object, d := objectValuable.ToObjectValue(ctx)
+		if d.HasError() {
+			response.Diagnostics.Append(d...)
+			return
+		}
+		if object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		attributes := object.Attributes()
+		identityType, ok := attributes["type"].(types.String)
+		if !ok || identityType.IsNull() || identityType.IsUnknown() {
+			continue
+		}
+		identityIds, known := identityIdsFromAttribute(ctx, attributes["identity_ids"])
+		if !known {
+			continue
+		}
+
+		for _, v := range c.capabilities.Validate(identity.Type(identityType.ValueString()), identityIds) {
+			response.Diagnostics.AddAttributeError(request.Path.AtListIndex(i).AtName(v.Field), v.Summary, v.Detail)
+		}
+	}
+}
+
+// identityIdsFromAttribute returns the values of the `identity_ids` attribute, which is either a List or a Set
+// depending on the schema in use, and whether these are known.
+func identityIdsFromAttribute(ctx context.Context, input attr.Value) ([]string, bool) {
+	output := make([]string, 0)
+	if input == nil || input.IsNull() {
+		return output, true
+	}
+	if input.IsUnknown() {
+		return nil, false
+	}
+
+	var elements []attr.Value
+	switch v := input.(type) {
+	case basetypes.SetValuable:
+		value, d := v.ToSetValue(ctx)
+		if d.HasError() {
+			return nil, false
+		}
+		elements = value.Elements()
+	case basetypes.ListValuable:
+		value, d := v.ToListValue(ctx)
+		if d.HasError() {
+			return nil, false
+		}
+		elements = value.Elements()
+	}
+
+	for _, element := range elements {
+		// an unknown value could still be any number of identities, so we can't validate these yet
+		if element.IsUnknown() {
+			return nil, false
+		}
+		if v, ok := element.(types.String); ok && !v.IsNull() {
+			output = append(output, v.ValueString())
+		}
+	}
+
+	return output, true
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/framework/identity"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	rmidentity "github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+func TestValidateCapabilities(t *testing.T) {
+	ctx := context.Background()
+	identityId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+
+	testData := []struct {
+		name         string
+		capabilities rmidentity.Capabilities
+		identityType types.String
+		identityIds  []attr.Value
+		expected     []path.Path
+	}{
+		{
+			name:         "system assigned",
+			identityType: types.StringValue("SystemAssigned"),
+		},
+		{
+			name:         "system assigned with identity ids",
+			identityType: types.StringValue("SystemAssigned"),
+			identityIds:  []attr.Value{types.StringValue(identityId)},
+			expected:     []path.Path{path.Root("identity").AtListIndex(0).AtName("identity_ids")},
+		},
+		{
+			name:         "user assigned without identity ids",
+			identityType: types.StringValue("UserAssigned"),
+			expected:     []path.Path{path.Root("identity").AtListIndex(0).AtName("identity_ids")},
+		},
+		{
+			name:         "user assigned with unknown identity ids",
+			identityType: types.StringValue("UserAssigned"),
+			identityIds:  []attr.Value{types.StringUnknown()},
+		},
+		{
+			name: "unsupported type",
+			capabilities: rmidentity.Capabilities{
+				SupportedTypes: []rmidentity.Type{rmidentity.TypeSystemAssigned},
+			},
+			identityType: types.StringValue("UserAssigned"),
+			identityIds:  []attr.Value{types.StringValue(identityId)},
+			expected:     []path.Path{path.Root("identity").AtListIndex(0).AtName("type")},
+		},
+		{
+			name:         "unknown type",
+			identityType: types.StringUnknown(),
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		identityIds := typehelpers.NewSetValueOfNull[types.String](ctx)
+		if v.identityIds != nil {
+			identityIds = typehelpers.NewSetValueOfMust[types.String](ctx, v.identityIds)
+		}
+		input := typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityModel{
+			{
+				Type:        v.identityType,
+				IdentityIDs: identityIds,
+				PrincipalID: types.StringUnknown(),
+				TenantID:    types.StringUnknown(),
+			},
+		})
+		configValue, d := input.ToListValue(ctx)
+		if d.HasError() {
+			t.Fatalf("building config value: %+v", d)
+		}
+
+		request := validator.ListRequest{
+			Path:        path.Root("identity"),
+			ConfigValue: configValue,
+		}
+		response := validator.ListResponse{}
+		identity.ValidateCapabilities(v.capabilities).ValidateList(ctx, request, &response)
+
+		errors := response.Diagnostics.Errors()
+		if len(errors) != len(v.expected) {
+			t.Fatalf("expected %d errors but got %d: %+v", len(v.expected), len(errors), errors)
+		}
+		for i, expected := range v.expected {
+			withPath, ok := errors[i].(interface{ Path() path.Path })
+			if !ok || !withPath.Path().Equal(expected) {
+				t.Fatalf("expected an error at %s but got %+v", expected, errors[i])
+			}
+		}
+	}
+}
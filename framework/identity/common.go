@@ -15,7 +15,6 @@ import (
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -66,21 +65,22 @@ func IdentityResourceAttributeSchema(ctx context.Context, validTypes ...identity
 				"principal_id": schema.StringAttribute{
 					Computed: true,
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.UseStateForUnknown(),
+						SystemAssignedComputedPlanModifier(),
 					},
 				},
 
 				"tenant_id": schema.StringAttribute{
 					Computed: true,
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.UseStateForUnknown(),
+						SystemAssignedComputedPlanModifier(),
 					},
 				},
 			},
 		},
 		Validators: []validator.List{
 			listvalidator.SizeAtMost(1),
-			// TODO - listvalidator for checking type and identityIds validation
+			// the supported types are validated on the `type` attribute itself
+			ValidateCapabilities(identity.Capabilities{}),
 		},
 	}
 }
@@ -151,21 +151,22 @@ func IdentityResourceBlockSchema(ctx context.Context, validTypes ...identity.Typ
 				"principal_id": schema.StringAttribute{
 					Computed: true,
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.UseStateForUnknown(),
+						SystemAssignedComputedPlanModifier(),
 					},
 				},
 
 				"tenant_id": schema.StringAttribute{
 					Computed: true,
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.UseStateForUnknown(),
+						SystemAssignedComputedPlanModifier(),
 					},
 				},
 			},
 		},
 		Validators: []validator.List{
 			listvalidator.SizeAtMost(1),
-			// TODO - listvalidator for checking type and identityIds validation
+			// the supported types are validated on the `type` attribute itself
+			ValidateCapabilities(identity.Capabilities{}),
 		},
 	}
 }
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type DelegatedResourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	ResourceID       types.String `tfsdk:"resource_id"`
+	TenantID         types.String `tfsdk:"tenant_id"`
+	ReferralResource types.String `tfsdk:"referral_resource"`
+	Location         types.String `tfsdk:"location"`
+}
+
+// DelegatedResourcesResourceAttributeSchema returns a Framework resource attribute schema for the
+// Delegated Resources of an Identity
+func DelegatedResourcesResourceAttributeSchema(ctx context.Context) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		CustomType: typehelpers.NewListNestedObjectTypeOf[DelegatedResourceModel](ctx),
+		Optional:   true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+
+				"resource_id": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						typehelpers.WrappedStringValidator{
+							Func: commonids.ValidateAnyScopeIDOfKind(commonids.ScopeKindResource),
+						},
+					},
+				},
+
+				"tenant_id": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						typehelpers.WrappedStringValidator{
+							Func: validation.IsUUID,
+						},
+					},
+				},
+
+				"referral_resource": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+
+				"location": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// DelegatedResourcesDataSourceAttributeSchema returns a Framework data source attribute schema for the
+// Delegated Resources of an Identity
+func DelegatedResourcesDataSourceAttributeSchema(ctx context.Context) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		CustomType: typehelpers.NewListNestedObjectTypeOf[DelegatedResourceModel](ctx),
+		Computed:   true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"resource_id": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"tenant_id": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"referral_resource": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"location": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ExpandToDelegatedResources(ctx context.Context, input typehelpers.ListNestedObjectValueOf[DelegatedResourceModel], result *map[string]identity.DelegatedResource, diags *diag.Diagnostics) {
+	if result == nil {
+		diags.AddError("Expanding delegated resources", "could not expand delegated resources as target was a nil pointer")
+		return
+	}
+
+	output := make(map[string]identity.DelegatedResource)
+	if input.IsNull() || input.IsUnknown() {
+		*result = output
+		return
+	}
+
+	delegatedResources := make([]DelegatedResourceModel, len(input.Elements()))
+	d := input.ElementsAs(ctx, &delegatedResources, true)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+
+	for _, v := range delegatedResources {
+		name := v.Name.ValueString()
+		if _, exists := output[name]; exists {
+			diags.AddError("Expanding delegated resources", fmt.Sprintf("the Delegated Resource %q was specified more than once", name))
+			return
+		}
+
+		output[name] = identity.DelegatedResource{
+			Location:         v.Location.ValueString(),
+			ReferralResource: v.ReferralResource.ValueString(),
+			ResourceId:       v.ResourceID.ValueString(),
+			TenantId:         v.TenantID.ValueString(),
+		}
+	}
+
+	*result = output
+}
+
+func FlattenFromDelegatedResources(ctx context.Context, input map[string]identity.DelegatedResource, result *typehelpers.ListNestedObjectValueOf[DelegatedResourceModel], diags *diag.Diagnostics) {
+	if input == nil {
+		*result = typehelpers.NewListNestedObjectValueOfNull[DelegatedResourceModel](ctx)
+		return
+	}
+
+	output := make([]DelegatedResourceModel, 0)
+	for _, v := range identity.FlattenDelegatedResourcesToModel(input) {
+		output = append(output, DelegatedResourceModel{
+			Name:             types.StringValue(v.Name),
+			ResourceID:       types.StringValue(v.ResourceId),
+			TenantID:         stringValueOrNull(v.TenantId),
+			ReferralResource: stringValueOrNull(v.ReferralResource),
+			Location:         types.StringValue(v.Location),
+		})
+	}
+
+	r, d := typehelpers.NewListNestedObjectValueOfValueSlice(ctx, output)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+
+	*result = r
+}
+
+// stringValueOrNull returns a null String for an empty value, for Optional (non-Computed) attributes
+func stringValueOrNull(input string) types.String {
+	if input == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(input)
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/framework/identity"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	rmidentity "github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+func TestExpandFlattenDelegatedResources(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	input := typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.DelegatedResourceModel{
+		{
+			Name:             types.StringValue("first"),
+			ResourceID:       types.StringValue("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster1"),
+			TenantID:         types.StringValue("11111111-1111-1111-1111-111111111111"),
+			ReferralResource: types.StringNull(),
+			Location:         types.StringValue("westeurope"),
+		},
+	})
+
+	expanded := map[string]rmidentity.DelegatedResource{}
+	identity.ExpandToDelegatedResources(ctx, input, &expanded, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %+v", diags.Errors())
+	}
+	expected := map[string]rmidentity.DelegatedResource{
+		"first": {
+			ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster1",
+			TenantId:   "11111111-1111-1111-1111-111111111111",
+			Location:   "westeurope",
+		},
+	}
+	if !reflect.DeepEqual(expected, expanded) {
+		t.Fatalf("expected %+v but got %+v", expected, expanded)
+	}
+
+	flattened := typehelpers.ListNestedObjectValueOf[identity.DelegatedResourceModel]{}
+	identity.FlattenFromDelegatedResources(ctx, expanded, &flattened, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %+v", diags.Errors())
+	}
+	if !input.Equal(flattened) {
+		t.Fatalf("expected %+v but got %+v", input, flattened)
+	}
+
+	identity.FlattenFromDelegatedResources(ctx, nil, &flattened, &diags)
+	if !flattened.IsNull() {
+		t.Fatalf("expected a null value when flattening nil but got %+v", flattened)
+	}
+}
+
+func TestExpandDelegatedResourcesDuplicateName(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	item := identity.DelegatedResourceModel{
+		Name:             types.StringValue("first"),
+		ResourceID:       types.StringValue("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster1"),
+		TenantID:         types.StringNull(),
+		ReferralResource: types.StringNull(),
+		Location:         types.StringNull(),
+	}
+	input := typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.DelegatedResourceModel{item, item})
+
+	expanded := map[string]rmidentity.DelegatedResource{}
+	identity.ExpandToDelegatedResources(ctx, input, &expanded, &diags)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for a duplicate name but didn't get one")
+	}
+}
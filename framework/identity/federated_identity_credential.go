@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type FederatedIdentityCredentialModel struct {
+	Name      types.String                          `tfsdk:"name"`
+	Issuer    types.String                          `tfsdk:"issuer"`
+	Subject   types.String                          `tfsdk:"subject"`
+	Audiences typehelpers.ListValueOf[types.String] `tfsdk:"audiences"`
+}
+
+// FederatedIdentityCredentialsResourceAttributeSchema returns a Framework resource attribute schema for the
+// Federated Identity Credentials of an Identity
+func FederatedIdentityCredentialsResourceAttributeSchema(ctx context.Context) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		CustomType: typehelpers.NewListNestedObjectTypeOf[FederatedIdentityCredentialModel](ctx),
+		Optional:   true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+
+				"issuer": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						typehelpers.WrappedStringValidator{
+							Func: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+
+				"subject": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+
+				"audiences": schema.ListAttribute{
+					CustomType:  typehelpers.NewListTypeOf[types.String](ctx),
+					ElementType: types.StringType,
+					Required:    true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+						listvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+// FederatedIdentityCredentialsDataSourceAttributeSchema returns a Framework data source attribute schema for the
+// Federated Identity Credentials of an Identity
+func FederatedIdentityCredentialsDataSourceAttributeSchema(ctx context.Context) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		CustomType: typehelpers.NewListNestedObjectTypeOf[FederatedIdentityCredentialModel](ctx),
+		Computed:   true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"issuer": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"subject": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"audiences": schema.ListAttribute{
+					CustomType:  typehelpers.NewListTypeOf[types.String](ctx),
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func ExpandToFederatedIdentityCredentials(ctx context.Context, input typehelpers.ListNestedObjectValueOf[FederatedIdentityCredentialModel], result *[]identity.FederatedIdentityCredential, diags *diag.Diagnostics) {
+	if result == nil {
+		diags.AddError("Expanding federated identity credentials", "could not expand federated identity credentials as target was a nil pointer")
+		return
+	}
+
+	output := make([]identity.FederatedIdentityCredential, 0)
+	if input.IsNull() || input.IsUnknown() {
+		*result = output
+		return
+	}
+
+	credentials := make([]FederatedIdentityCredentialModel, len(input.Elements()))
+	d := input.ElementsAs(ctx, &credentials, true)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+
+	names := make(map[string]struct{})
+	for _, v := range credentials {
+		name := v.Name.ValueString()
+		if _, exists := names[name]; exists {
+			diags.AddError("Expanding federated identity credentials", fmt.Sprintf("the Federated Identity Credential %q was specified more than once", name))
+			return
+		}
+		names[name] = struct{}{}
+
+		audiences := make([]string, 0)
+		d := v.Audiences.ElementsAs(ctx, &audiences, false)
+		if d.HasError() {
+			diags.Append(d...)
+			return
+		}
+
+		output = append(output, identity.FederatedIdentityCredential{
+			Name:      name,
+			Issuer:    v.Issuer.ValueString(),
+			Subject:   v.Subject.ValueString(),
+			Audiences: audiences,
+		})
+	}
+
+	*result = output
+}
+
+func FlattenFromFederatedIdentityCredentials(ctx context.Context, input *[]identity.FederatedIdentityCredential, result *typehelpers.ListNestedObjectValueOf[FederatedIdentityCredentialModel], diags *diag.Diagnostics) {
+	if input == nil {
+		*result = typehelpers.NewListNestedObjectValueOfNull[FederatedIdentityCredentialModel](ctx)
+		return
+	}
+
+	output := make([]FederatedIdentityCredentialModel, 0)
+	for _, v := range *input {
+		audiences := make([]attr.Value, 0)
+		for _, audience := range v.Audiences {
+			audiences = append(audiences, types.StringValue(audience))
+		}
+
+		audiencesValue, d := typehelpers.NewListValueOf[types.String](ctx, audiences)
+		if d.HasError() {
+			diags.Append(d...)
+			return
+		}
+
+		output = append(output, FederatedIdentityCredentialModel{
+			Name:      types.StringValue(v.Name),
+			Issuer:    types.StringValue(v.Issuer),
+			Subject:   types.StringValue(v.Subject),
+			Audiences: audiencesValue,
+		})
+	}
+
+	r, d := typehelpers.NewListNestedObjectValueOfValueSlice(ctx, output)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+
+	*result = r
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/framework/identity"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	rmidentity "github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+func TestExpandFlattenFederatedIdentityCredentials(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	input := typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.FederatedIdentityCredentialModel{
+		{
+			Name:    types.StringValue("github"),
+			Issuer:  types.StringValue("https://token.actions.githubusercontent.com"),
+			Subject: types.StringValue("repo:hashicorp/go-azure-helpers:ref:refs/heads/main"),
+			Audiences: typehelpers.NewListValueOfMust[types.String](ctx, []attr.Value{
+				types.StringValue("api://AzureADTokenExchange"),
+			}),
+		},
+	})
+
+	expanded := make([]rmidentity.FederatedIdentityCredential, 0)
+	identity.ExpandToFederatedIdentityCredentials(ctx, input, &expanded, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %+v", diags.Errors())
+	}
+	expected := []rmidentity.FederatedIdentityCredential{
+		{
+			Name:      "github",
+			Issuer:    "https://token.actions.githubusercontent.com",
+			Subject:   "repo:hashicorp/go-azure-helpers:ref:refs/heads/main",
+			Audiences: []string{"api://AzureADTokenExchange"},
+		},
+	}
+	if !reflect.DeepEqual(expected, expanded) {
+		t.Fatalf("expected %+v but got %+v", expected, expanded)
+	}
+
+	flattened := typehelpers.ListNestedObjectValueOf[identity.FederatedIdentityCredentialModel]{}
+	identity.FlattenFromFederatedIdentityCredentials(ctx, &expanded, &flattened, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %+v", diags.Errors())
+	}
+	if !input.Equal(flattened) {
+		t.Fatalf("expected %+v but got %+v", input, flattened)
+	}
+}
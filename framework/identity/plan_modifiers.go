@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type systemAssignedComputedPlanModifier struct{}
+
+var _ planmodifier.String = &systemAssignedComputedPlanModifier{}
+
+// SystemAssignedComputedPlanModifier is intended for the computed `principal_id` and `tenant_id` attributes
+// within an `identity` block. The prior value is kept whilst the System Assigned Identity remains, the value is
+// planned as null when the Identity Type no longer includes a System Assigned Identity, and the value is left
+// unknown only when the System Assigned Identity is being created.
+func SystemAssignedComputedPlanModifier() planmodifier.String {
+	return &systemAssignedComputedPlanModifier{}
+}
+
+func (s systemAssignedComputedPlanModifier) Description(_ context.Context) string {
+	return "Keeps the System Assigned Identity's computed values stable unless the System Assigned Identity is being created or removed."
+}
+
+func (s systemAssignedComputedPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return s.Description(ctx)
+}
+
+func (s systemAssignedComputedPlanModifier) PlanModifyString(ctx context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	// nothing to do when destroying, or when a value is already known
+	if request.Plan.Raw.IsNull() || !request.PlanValue.IsUnknown() {
+		return
+	}
+
+	typePath := request.Path.ParentPath().AtName("type")
+
+	var plannedType types.String
+	if d := request.Plan.GetAttribute(ctx, typePath, &plannedType); d.HasError() {
+		response.Diagnostics.Append(d...)
+		return
+	}
+	if plannedType.IsUnknown() {
+		return
+	}
+
+	if !typeIncludesSystemAssigned(plannedType.ValueString()) {
+		response.PlanValue = types.StringNull()
+		return
+	}
+
+	// when the `identity` block is new the prior type won't exist, which we treat as null
+	var priorType types.String
+	if !request.State.Raw.IsNull() {
+		if d := request.State.GetAttribute(ctx, typePath, &priorType); d.HasError() {
+			priorType = types.StringNull()
+		}
+	}
+	if priorType.IsNull() || !typeIncludesSystemAssigned(priorType.ValueString()) {
+		// the System Assigned Identity is being created, so the value can't be known until apply
+		return
+	}
+
+	if !request.StateValue.IsNull() {
+		response.PlanValue = request.StateValue
+	}
+}
+
+// systemAssignedValue returns the value for the computed `principal_id` and `tenant_id` attributes, which are null
+// when the Identity Type doesn't include a System Assigned Identity - matching the value planned by
+// SystemAssignedComputedPlanModifier
+func systemAssignedValue(identityType identity.Type, value string) types.String {
+	if !typeIncludesSystemAssigned(string(identityType)) {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// typeIncludesSystemAssigned ignores whitespace so that the legacy `SystemAssigned,UserAssigned` type also matches
+func typeIncludesSystemAssigned(input string) bool {
+	for _, v := range []identity.Type{identity.TypeSystemAssigned, identity.TypeSystemAssignedUserAssigned} {
+		if strings.EqualFold(strings.ReplaceAll(input, " ", ""), strings.ReplaceAll(string(v), " ", "")) {
+			return true
+		}
+	}
+	return false
+}
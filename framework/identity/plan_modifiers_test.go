@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/framework/identity"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	rmidentity "github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSystemAssignedComputedPlanModifier(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"identity": identity.IdentityResourceAttributeSchema(ctx),
+		},
+	}
+
+	testData := []struct {
+		name      string
+		priorType *string
+		planType  types.String
+		expected  types.String
+	}{
+		{
+			name:      "creating system assigned",
+			priorType: nil,
+			planType:  types.StringValue("SystemAssigned"),
+			expected:  types.StringUnknown(),
+		},
+		{
+			name:      "unchanged system assigned",
+			priorType: pointerTo("SystemAssigned"),
+			planType:  types.StringValue("SystemAssigned"),
+			expected:  types.StringValue("11111111-1111-1111-1111-111111111111"),
+		},
+		{
+			name:      "system assigned to system and user assigned",
+			priorType: pointerTo("SystemAssigned"),
+			planType:  types.StringValue("SystemAssigned, UserAssigned"),
+			expected:  types.StringValue("11111111-1111-1111-1111-111111111111"),
+		},
+		{
+			name:      "user assigned to system assigned",
+			priorType: pointerTo("UserAssigned"),
+			planType:  types.StringValue("SystemAssigned"),
+			expected:  types.StringUnknown(),
+		},
+		{
+			name:      "system assigned to user assigned",
+			priorType: pointerTo("SystemAssigned"),
+			planType:  types.StringValue("UserAssigned"),
+			expected:  types.StringNull(),
+		},
+		{
+			name:      "unchanged user assigned",
+			priorType: pointerTo("UserAssigned"),
+			planType:  types.StringValue("UserAssigned"),
+			expected:  types.StringNull(),
+		},
+		{
+			name:      "unknown type",
+			priorType: pointerTo("SystemAssigned"),
+			planType:  types.StringUnknown(),
+			expected:  types.StringUnknown(),
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		state := tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		}
+		stateValue := types.StringNull()
+		if v.priorType != nil {
+			stateValue = types.StringValue("11111111-1111-1111-1111-111111111111")
+			if *v.priorType == "UserAssigned" {
+				stateValue = types.StringNull()
+			}
+			setIdentity(t, ctx, &state, types.StringValue(*v.priorType), stateValue)
+		}
+
+		plan := tfsdk.Plan{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		}
+		setIdentity(t, ctx, &plan, v.planType, types.StringUnknown())
+
+		request := planmodifier.StringRequest{
+			Path:       path.Root("identity").AtListIndex(0).AtName("principal_id"),
+			Plan:       plan,
+			PlanValue:  types.StringUnknown(),
+			State:      state,
+			StateValue: stateValue,
+		}
+		response := planmodifier.StringResponse{
+			PlanValue: request.PlanValue,
+		}
+		identity.SystemAssignedComputedPlanModifier().PlanModifyString(ctx, request, &response)
+		if response.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %+v", response.Diagnostics)
+		}
+		if !response.PlanValue.Equal(v.expected) {
+			t.Fatalf("expected %s but got %s", v.expected, response.PlanValue)
+		}
+	}
+}
+
+func TestSystemAssignedComputedPlanModifierMatchesFlatten(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"identity": identity.IdentityResourceAttributeSchema(ctx),
+		},
+	}
+
+	testData := []struct {
+		name  string
+		input *rmidentity.SystemAndUserAssignedList
+	}{
+		{
+			name: "none",
+			input: &rmidentity.SystemAndUserAssignedList{
+				Type: rmidentity.TypeNone,
+			},
+		},
+		{
+			name: "system assigned",
+			input: &rmidentity.SystemAndUserAssignedList{
+				Type:        rmidentity.TypeSystemAssigned,
+				PrincipalId: "11111111-1111-1111-1111-111111111111",
+				TenantId:    "22222222-2222-2222-2222-222222222222",
+			},
+		},
+		{
+			name: "user assigned",
+			input: &rmidentity.SystemAndUserAssignedList{
+				Type: rmidentity.TypeUserAssigned,
+				IdentityIds: []string{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first",
+				},
+			},
+		},
+		{
+			name: "system and user assigned",
+			input: &rmidentity.SystemAndUserAssignedList{
+				Type:        rmidentity.TypeSystemAssignedUserAssigned,
+				PrincipalId: "11111111-1111-1111-1111-111111111111",
+				TenantId:    "22222222-2222-2222-2222-222222222222",
+				IdentityIds: []string{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first",
+				},
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		diags := diag.Diagnostics{}
+		flattened := typehelpers.NewListNestedObjectValueOfNull[identity.IdentityModel](ctx)
+		identity.FlattenFromSystemAndUserAssignedList(ctx, v.input, &flattened, &diags)
+		if diags.HasError() {
+			t.Fatalf("flattening identity: %+v", diags)
+		}
+		flattenedModels, d := flattened.ToSlice(ctx)
+		if d.HasError() {
+			t.Fatalf("reading flattened identity: %+v", d)
+		}
+		flattenedModel := flattenedModels[0]
+
+		state := tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		}
+		if d := state.SetAttribute(ctx, path.Root("identity"), flattened); d.HasError() {
+			t.Fatalf("setting state: %+v", d)
+		}
+
+		plan := tfsdk.Plan{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		}
+		setIdentity(t, ctx, &plan, flattenedModel.Type, types.StringUnknown())
+
+		request := planmodifier.StringRequest{
+			Path:       path.Root("identity").AtListIndex(0).AtName("principal_id"),
+			Plan:       plan,
+			PlanValue:  types.StringUnknown(),
+			State:      state,
+			StateValue: flattenedModel.PrincipalID,
+		}
+		response := planmodifier.StringResponse{
+			PlanValue: request.PlanValue,
+		}
+		identity.SystemAssignedComputedPlanModifier().PlanModifyString(ctx, request, &response)
+		if response.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %+v", response.Diagnostics)
+		}
+
+		// the value planned for an unchanged identity must match what is flattened after apply
+		if !response.PlanValue.Equal(flattenedModel.PrincipalID) {
+			t.Fatalf("expected the planned value %s to match the flattened value %s", response.PlanValue, flattenedModel.PrincipalID)
+		}
+	}
+}
+
+type attributeSetter interface {
+	SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics
+}
+
+func setIdentity(t *testing.T, ctx context.Context, target attributeSetter, identityType types.String, principalId types.String) {
+	value := typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityModel{
+		{
+			Type:        identityType,
+			IdentityIDs: typehelpers.NewSetValueOfNull[types.String](ctx),
+			PrincipalID: principalId,
+			TenantID:    principalId,
+		},
+	})
+	if d := target.SetAttribute(ctx, path.Root("identity"), value); d.HasError() {
+		t.Fatalf("setting identity: %+v", d)
+	}
+}
+
+func pointerTo(input string) *string {
+	return &input
+}
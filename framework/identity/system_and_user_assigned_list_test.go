@@ -153,8 +153,8 @@ func TestFlattenSystemAndUserAssignedList(t *testing.T) {
 			Expected: typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityModel{
 				{
 					Type:        types.StringValue(string(rmidentity.TypeNone)),
-					PrincipalID: types.StringValue(""),
-					TenantID:    types.StringValue(""),
+					PrincipalID: types.StringNull(),
+					TenantID:    types.StringNull(),
 					IdentityIDs: typehelpers.NewSetValueOfNull[types.String](ctx),
 				},
 			}),
@@ -49,6 +49,10 @@ func ExpandToSystemAndUserAssignedMap(ctx context.Context, input typehelpers.Lis
 		identities := map[string]identity.UserAssignedIdentityDetails{}
 		idList := make([]string, 0)
 		ident.IdentityIDs.ElementsAs(ctx, &idList, false)
+		idList = expandIdentityIds(idList, diags)
+		if diags.HasError() {
+			return
+		}
 
 		for _, id := range idList {
 			identities[id] = identity.UserAssignedIdentityDetails{}
@@ -71,8 +75,8 @@ func FlattenFromSystemAndUserAssignedMap(ctx context.Context, input *identity.Sy
 
 	ident := IdentityModel{
 		Type:        types.StringValue(string(i.Type)),
-		PrincipalID: types.StringValue(i.PrincipalId),
-		TenantID:    types.StringValue(i.TenantId),
+		PrincipalID: systemAssignedValue(i.Type, i.PrincipalId),
+		TenantID:    systemAssignedValue(i.Type, i.TenantId),
 	}
 
 	if len(i.IdentityIds) > 0 {
@@ -153,8 +153,8 @@ func TestFlattenSystemAndUserAssignedMap(t *testing.T) {
 			Expected: typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityModel{
 				{
 					Type:        types.StringValue(string(rmidentity.TypeNone)),
-					PrincipalID: types.StringValue(""),
-					TenantID:    types.StringValue(""),
+					PrincipalID: types.StringNull(),
+					TenantID:    types.StringNull(),
 					IdentityIDs: typehelpers.NewSetValueOfNull[types.String](ctx),
 				},
 			}),
@@ -233,3 +233,26 @@ func TestFlattenSystemAndUserAssignedMap(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandSystemAndUserAssignedMapCaseInsensitiveDuplicates(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	input := typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityModel{
+		{
+			Type:        types.StringValue(string(rmidentity.TypeUserAssigned)),
+			PrincipalID: types.StringNull(),
+			TenantID:    types.StringNull(),
+			IdentityIDs: typehelpers.NewSetValueOfMust[types.String](ctx, []attr.Value{
+				types.StringValue("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first"),
+				types.StringValue("/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/first"),
+			}),
+		},
+	})
+
+	result := &rmidentity.SystemAndUserAssignedMap{}
+	identity.ExpandToSystemAndUserAssignedMap(ctx, input, result, &diags)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for a duplicate User Assigned Identity but didn't get one")
+	}
+}
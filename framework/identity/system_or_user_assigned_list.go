@@ -39,6 +39,7 @@ func ExpandToSystemOrUserAssignedList(ctx context.Context, input typehelpers.Lis
 	if len(identityList) == 1 {
 		ident := identityList[0]
 		convert.Expand(ctx, ident, result, diags)
+		result.IdentityIds = expandIdentityIds(result.IdentityIds, diags)
 	}
 }
 
@@ -55,6 +56,9 @@ func FlattenFromSystemOrUserAssignedList(ctx context.Context, input *identity.Sy
 	}
 
 	convert.Flatten(ctx, input, &flat, diags)
+	flat.PrincipalID = systemAssignedValue(input.Type, flat.PrincipalID.ValueString())
+	flat.TenantID = systemAssignedValue(input.Type, flat.TenantID.ValueString())
+
 	list, d := typehelpers.NewListNestedObjectValueOfValueSlice(ctx, []IdentityModel{flat})
 	if d.HasError() {
 		diags.Append(d...)
@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: the `user_assigned_identities` attribute exposes the Client ID and Principal ID of each User Assigned Identity
+// and is opt-in - the Identity schema must be wrapped using WithUserAssignedIdentityDetails (or the Block equivalents),
+// with the value populated using the `FlattenFromXXXMapWithDetails` functions, since this is only available for the Map types.
+
+type UserAssignedIdentityModel struct {
+	ID          types.String `tfsdk:"id"`
+	ClientID    types.String `tfsdk:"client_id"`
+	PrincipalID types.String `tfsdk:"principal_id"`
+}
+
+// IdentityWithDetailsModel is the model for an Identity schema wrapped using WithUserAssignedIdentityDetails, which
+// additionally exposes the Client ID and Principal ID for each User Assigned Identity.
+type IdentityWithDetailsModel struct {
+	Type                   types.String                                                   `tfsdk:"type"`
+	IdentityIDs            typehelpers.SetValueOf[types.String]                           `tfsdk:"identity_ids" convert:"IdentityIds"`
+	PrincipalID            types.String                                                   `tfsdk:"principal_id" convert:"PrincipalId"`
+	TenantID               types.String                                                   `tfsdk:"tenant_id" convert:"TenantId"`
+	UserAssignedIdentities typehelpers.ListNestedObjectValueOf[UserAssignedIdentityModel] `tfsdk:"user_assigned_identities"`
+}
+
+// WithUserAssignedIdentityDetails adds the Computed `user_assigned_identities` attribute to the Identity schema `input`,
+// for example `WithUserAssignedIdentityDetails(ctx, IdentityResourceAttributeSchema(ctx))`
+func WithUserAssignedIdentityDetails(ctx context.Context, input schema.ListNestedAttribute) schema.ListNestedAttribute {
+	input.CustomType = typehelpers.NewListNestedObjectTypeOf[IdentityWithDetailsModel](ctx)
+	input.NestedObject.Attributes = withUserAssignedIdentitiesAttribute(ctx, input.NestedObject.Attributes)
+	return input
+}
+
+// WithUserAssignedIdentityDetailsBlock adds the Computed `user_assigned_identities` attribute to the Identity Block
+// schema `input`, for example `WithUserAssignedIdentityDetailsBlock(ctx, IdentityResourceBlockSchema(ctx))`
+func WithUserAssignedIdentityDetailsBlock(ctx context.Context, input schema.ListNestedBlock) schema.ListNestedBlock {
+	input.CustomType = typehelpers.NewListNestedObjectTypeOf[IdentityWithDetailsModel](ctx)
+	input.NestedObject.Attributes = withUserAssignedIdentitiesAttribute(ctx, input.NestedObject.Attributes)
+	return input
+}
+
+// WithUserAssignedIdentityDetailsDataSourceBlock adds the Computed `user_assigned_identities` attribute to the Identity
+// Data Source Block schema `input`, for example `WithUserAssignedIdentityDetailsDataSourceBlock(ctx, IdentityDataSourceBlockSchema(ctx))`
+func WithUserAssignedIdentityDetailsDataSourceBlock(ctx context.Context, input datasourceschema.ListNestedBlock) datasourceschema.ListNestedBlock {
+	input.CustomType = typehelpers.NewListNestedObjectTypeOf[IdentityWithDetailsModel](ctx)
+
+	attributes := make(map[string]datasourceschema.Attribute, len(input.NestedObject.Attributes)+1)
+	for k, v := range input.NestedObject.Attributes {
+		attributes[k] = v
+	}
+	attributes["user_assigned_identities"] = userAssignedIdentitiesAttributeSchema(ctx)
+	input.NestedObject.Attributes = attributes
+
+	return input
+}
+
+func withUserAssignedIdentitiesAttribute(ctx context.Context, input map[string]schema.Attribute) map[string]schema.Attribute {
+	// copy the attributes so that the input schema isn't modified
+	output := make(map[string]schema.Attribute, len(input)+1)
+	for k, v := range input {
+		output[k] = v
+	}
+	output["user_assigned_identities"] = userAssignedIdentitiesAttributeSchema(ctx)
+
+	return output
+}
+
+// userAssignedIdentitiesAttributeSchema returns the Computed `user_assigned_identities` attribute, which exposes
+// the Client ID and Principal ID for each User Assigned Identity
+func userAssignedIdentitiesAttributeSchema(ctx context.Context) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		CustomType: typehelpers.NewListNestedObjectTypeOf[UserAssignedIdentityModel](ctx),
+		Computed:   true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"client_id": schema.StringAttribute{
+					Computed: true,
+				},
+
+				"principal_id": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// IdentityModelFromDetails converts the value of an Identity schema wrapped using WithUserAssignedIdentityDetails into
+// an IdentityModel, so that it can be expanded using the `ExpandToXXX` functions
+func IdentityModelFromDetails(ctx context.Context, input typehelpers.ListNestedObjectValueOf[IdentityWithDetailsModel], diags *diag.Diagnostics) typehelpers.ListNestedObjectValueOf[IdentityModel] {
+	if input.IsNull() {
+		return typehelpers.NewListNestedObjectValueOfNull[IdentityModel](ctx)
+	}
+	if input.IsUnknown() {
+		return typehelpers.NewListNestedObjectValueOfUnknown[IdentityModel](ctx)
+	}
+
+	identities := make([]IdentityWithDetailsModel, 0)
+	if d := input.ElementsAs(ctx, &identities, false); d.HasError() {
+		diags.Append(d...)
+		return typehelpers.NewListNestedObjectValueOfNull[IdentityModel](ctx)
+	}
+
+	output := make([]IdentityModel, 0)
+	for _, v := range identities {
+		output = append(output, IdentityModel{
+			Type:        v.Type,
+			IdentityIDs: v.IdentityIDs,
+			PrincipalID: v.PrincipalID,
+			TenantID:    v.TenantID,
+		})
+	}
+
+	result, d := typehelpers.NewListNestedObjectValueOfValueSlice(ctx, output)
+	diags.Append(d...)
+	return result
+}
+
+// FlattenFromSystemAndUserAssignedMapWithDetails flattens the SystemAndUserAssignedMap into an Identity schema wrapped
+// using WithUserAssignedIdentityDetails
+func FlattenFromSystemAndUserAssignedMapWithDetails(ctx context.Context, input *identity.SystemAndUserAssignedMap, result *typehelpers.ListNestedObjectValueOf[IdentityWithDetailsModel], diags *diag.Diagnostics) {
+	flattened := typehelpers.NewListNestedObjectValueOfNull[IdentityModel](ctx)
+	FlattenFromSystemAndUserAssignedMap(ctx, input, &flattened, diags)
+
+	var identityIds map[string]identity.UserAssignedIdentityDetails
+	if input != nil {
+		identityIds = input.IdentityIds
+	}
+	*result = flattenWithUserAssignedIdentityDetails(ctx, flattened, identityIds, diags)
+}
+
+// FlattenFromSystemOrUserAssignedMapWithDetails flattens the SystemOrUserAssignedMap into an Identity schema wrapped
+// using WithUserAssignedIdentityDetails
+func FlattenFromSystemOrUserAssignedMapWithDetails(ctx context.Context, input *identity.SystemOrUserAssignedMap, result *typehelpers.ListNestedObjectValueOf[IdentityWithDetailsModel], diags *diag.Diagnostics) {
+	flattened := typehelpers.NewListNestedObjectValueOfNull[IdentityModel](ctx)
+	FlattenFromSystemOrUserAssignedMap(ctx, input, &flattened, diags)
+
+	var identityIds map[string]identity.UserAssignedIdentityDetails
+	if input != nil {
+		identityIds = input.IdentityIds
+	}
+	*result = flattenWithUserAssignedIdentityDetails(ctx, flattened, identityIds, diags)
+}
+
+// FlattenFromLegacySystemAndUserAssignedMapWithDetails flattens the LegacySystemAndUserAssignedMap into an Identity
+// schema wrapped using WithUserAssignedIdentityDetails
+func FlattenFromLegacySystemAndUserAssignedMapWithDetails(ctx context.Context, input *identity.LegacySystemAndUserAssignedMap, result *typehelpers.ListNestedObjectValueOf[IdentityWithDetailsModel], diags *diag.Diagnostics) {
+	flattened := typehelpers.NewListNestedObjectValueOfNull[IdentityModel](ctx)
+	FlattenFromLegacySystemAndUserAssignedMap(ctx, input, &flattened, diags)
+
+	var identityIds map[string]identity.UserAssignedIdentityDetails
+	if input != nil {
+		identityIds = input.IdentityIds
+	}
+	*result = flattenWithUserAssignedIdentityDetails(ctx, flattened, identityIds, diags)
+}
+
+// FlattenFromUserAssignedMapWithDetails flattens the UserAssignedMap into an Identity schema wrapped using
+// WithUserAssignedIdentityDetails
+func FlattenFromUserAssignedMapWithDetails(ctx context.Context, input *identity.UserAssignedMap, result *typehelpers.ListNestedObjectValueOf[IdentityWithDetailsModel], diags *diag.Diagnostics) {
+	flattened := typehelpers.NewListNestedObjectValueOfNull[IdentityModel](ctx)
+	FlattenFromUserAssignedMap(ctx, input, &flattened, diags)
+
+	var identityIds map[string]identity.UserAssignedIdentityDetails
+	if input != nil {
+		identityIds = input.IdentityIds
+	}
+	*result = flattenWithUserAssignedIdentityDetails(ctx, flattened, identityIds, diags)
+}
+
+func flattenWithUserAssignedIdentityDetails(ctx context.Context, input typehelpers.ListNestedObjectValueOf[IdentityModel], identityIds map[string]identity.UserAssignedIdentityDetails, diags *diag.Diagnostics) typehelpers.ListNestedObjectValueOf[IdentityWithDetailsModel] {
+	if diags.HasError() || input.IsNull() || input.IsUnknown() {
+		return typehelpers.NewListNestedObjectValueOfNull[IdentityWithDetailsModel](ctx)
+	}
+
+	identities := make([]IdentityModel, 0)
+	if d := input.ElementsAs(ctx, &identities, false); d.HasError() {
+		diags.Append(d...)
+		return typehelpers.NewListNestedObjectValueOfNull[IdentityWithDetailsModel](ctx)
+	}
+
+	output := make([]IdentityWithDetailsModel, 0)
+	for _, v := range identities {
+		output = append(output, IdentityWithDetailsModel{
+			Type:                   v.Type,
+			IdentityIDs:            v.IdentityIDs,
+			PrincipalID:            v.PrincipalID,
+			TenantID:               v.TenantID,
+			UserAssignedIdentities: flattenUserAssignedIdentities(ctx, identityIds, diags),
+		})
+	}
+
+	result, d := typehelpers.NewListNestedObjectValueOfValueSlice(ctx, output)
+	diags.Append(d...)
+	return result
+}
+
+// flattenUserAssignedIdentities turns the User Assigned Identities within a Map type into the value for the
+// `user_assigned_identities` attribute, sorted by the (normalized) User Assigned Identity ID.
+func flattenUserAssignedIdentities(ctx context.Context, input map[string]identity.UserAssignedIdentityDetails, diags *diag.Diagnostics) typehelpers.ListNestedObjectValueOf[UserAssignedIdentityModel] {
+	if len(input) == 0 {
+		return typehelpers.NewListNestedObjectValueOfNull[UserAssignedIdentityModel](ctx)
+	}
+
+	output := make([]UserAssignedIdentityModel, 0)
+	for raw, details := range input {
+		id := raw
+		if parsed, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw); err == nil {
+			id = parsed.ID()
+		}
+
+		output = append(output, UserAssignedIdentityModel{
+			ID:          types.StringValue(id),
+			ClientID:    types.StringPointerValue(details.ClientId),
+			PrincipalID: types.StringPointerValue(details.PrincipalId),
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].ID.ValueString() < output[j].ID.ValueString()
+	})
+
+	result, d := typehelpers.NewListNestedObjectValueOfValueSlice(ctx, output)
+	diags.Append(d...)
+	return result
+}
+
+// expandIdentityIds canonicalizes the User Assigned Identity IDs specified in the configuration, adding an error
+// diagnostic for any User Assigned Identity which was specified more than once (since these are case-insensitive)
+func expandIdentityIds(input []string, diags *diag.Diagnostics) []string {
+	ids, duplicates := identity.CanonicalizeIdentityIds(input)
+	for _, v := range duplicates {
+		diags.AddError("Expanding identity", fmt.Sprintf("the User Assigned Identity %q was specified more than once in `identity_ids` (User Assigned Identity IDs are case-insensitive)", v))
+	}
+
+	return ids
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/framework/identity"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	rmidentity "github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+func TestWithUserAssignedIdentityDetails(t *testing.T) {
+	ctx := context.Background()
+
+	input := identity.IdentityResourceAttributeSchema(ctx)
+	actual := identity.WithUserAssignedIdentityDetails(ctx, input)
+
+	if _, ok := actual.NestedObject.Attributes["user_assigned_identities"]; !ok {
+		t.Fatalf("expected the `user_assigned_identities` attribute to be added")
+	}
+	if _, ok := input.NestedObject.Attributes["user_assigned_identities"]; ok {
+		t.Fatalf("expected the input schema not to be modified")
+	}
+
+	block := identity.WithUserAssignedIdentityDetailsBlock(ctx, identity.IdentityResourceBlockSchema(ctx))
+	if _, ok := block.NestedObject.Attributes["user_assigned_identities"]; !ok {
+		t.Fatalf("expected the `user_assigned_identities` attribute to be added to the block")
+	}
+
+	dataSourceBlock := identity.WithUserAssignedIdentityDetailsDataSourceBlock(ctx, identity.IdentityDataSourceBlockSchema(ctx))
+	if _, ok := dataSourceBlock.NestedObject.Attributes["user_assigned_identities"]; !ok {
+		t.Fatalf("expected the `user_assigned_identities` attribute to be added to the data source block")
+	}
+}
+
+func TestFlattenSystemAndUserAssignedMapWithDetails(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		Name     string
+		Input    *rmidentity.SystemAndUserAssignedMap
+		Expected typehelpers.ListNestedObjectValueOf[identity.IdentityWithDetailsModel]
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: typehelpers.NewListNestedObjectValueOfNull[identity.IdentityWithDetailsModel](ctx),
+		},
+		{
+			Name: "SystemAssigned",
+			Input: &rmidentity.SystemAndUserAssignedMap{
+				Type:        rmidentity.TypeSystemAssigned,
+				PrincipalId: "000000-0000-0000-0000-000000000000",
+				TenantId:    "000000-0000-0000-0000-000000000001",
+				IdentityIds: map[string]rmidentity.UserAssignedIdentityDetails{},
+			},
+			Expected: typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityWithDetailsModel{
+				{
+					Type:                   types.StringValue(string(rmidentity.TypeSystemAssigned)),
+					PrincipalID:            types.StringValue("000000-0000-0000-0000-000000000000"),
+					TenantID:               types.StringValue("000000-0000-0000-0000-000000000001"),
+					IdentityIDs:            typehelpers.NewSetValueOfNull[types.String](ctx),
+					UserAssignedIdentities: typehelpers.NewListNestedObjectValueOfNull[identity.UserAssignedIdentityModel](ctx),
+				},
+			}),
+		},
+		{
+			Name: "UserAssigned with details",
+			Input: &rmidentity.SystemAndUserAssignedMap{
+				Type: rmidentity.TypeUserAssigned,
+				IdentityIds: map[string]rmidentity.UserAssignedIdentityDetails{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/first": {
+						ClientId:    pointer.To("000000-0000-0000-0000-000000000004"),
+						PrincipalId: pointer.To("000000-0000-0000-0000-000000000005"),
+					},
+				},
+			},
+			Expected: typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityWithDetailsModel{
+				{
+					Type:        types.StringValue(string(rmidentity.TypeUserAssigned)),
+					PrincipalID: types.StringNull(),
+					TenantID:    types.StringNull(),
+					IdentityIDs: typehelpers.NewSetValueOfMust[types.String](ctx, []attr.Value{
+						types.StringValue("/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/first"),
+					}),
+					UserAssignedIdentities: typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.UserAssignedIdentityModel{
+						{
+							ID:          types.StringValue("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first"),
+							ClientID:    types.StringValue("000000-0000-0000-0000-000000000004"),
+							PrincipalID: types.StringValue("000000-0000-0000-0000-000000000005"),
+						},
+					}),
+				},
+			}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		diags := diag.Diagnostics{}
+		result := typehelpers.NewListNestedObjectValueOfNull[identity.IdentityWithDetailsModel](ctx)
+		identity.FlattenFromSystemAndUserAssignedMapWithDetails(ctx, tc.Input, &result, &diags)
+		if diags.HasError() {
+			t.Fatalf("flattening: %+v", diags)
+		}
+
+		if !reflect.DeepEqual(tc.Expected, result) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, result)
+		}
+	}
+}
+
+func TestIdentityModelFromDetails(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	input := typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityWithDetailsModel{
+		{
+			Type:        types.StringValue(string(rmidentity.TypeUserAssigned)),
+			PrincipalID: types.StringNull(),
+			TenantID:    types.StringNull(),
+			IdentityIDs: typehelpers.NewSetValueOfMust[types.String](ctx, []attr.Value{
+				types.StringValue("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first"),
+			}),
+			UserAssignedIdentities: typehelpers.NewListNestedObjectValueOfUnknown[identity.UserAssignedIdentityModel](ctx),
+		},
+	})
+
+	result := &rmidentity.SystemAndUserAssignedMap{}
+	identity.ExpandToSystemAndUserAssignedMap(ctx, identity.IdentityModelFromDetails(ctx, input, &diags), result, &diags)
+	if diags.HasError() {
+		t.Fatalf("expanding: %+v", diags)
+	}
+
+	if result.Type != rmidentity.TypeUserAssigned || len(result.IdentityIds) != 1 {
+		t.Fatalf("expected a single User Assigned Identity but got %+v", result)
+	}
+
+	if actual := identity.IdentityModelFromDetails(ctx, typehelpers.NewListNestedObjectValueOfNull[identity.IdentityWithDetailsModel](ctx), &diags); !actual.IsNull() {
+		t.Fatalf("expected a null value but got %+v", actual)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/framework/convert"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ExpandToUserAssignedList(ctx context.Context, input typehelpers.ListNestedObjectValueOf[IdentityModel], result *identity.UserAssignedList, diags *diag.Diagnostics) {
+	if result == nil {
+		diags.AddError("Expanding identity", "could not expand identity as target was a nil pointer")
+		return
+	}
+
+	if input.IsNull() || input.IsUnknown() || len(input.Elements()) == 0 {
+		result.Type = identity.TypeNone
+		result.IdentityIds = nil
+
+		return
+	}
+
+	identityList := make([]IdentityModel, len(input.Elements()))
+
+	d := input.ElementsAs(ctx, &identityList, true)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+
+	if len(identityList) == 1 {
+		ident := identityList[0]
+		convert.Expand(ctx, ident, result, diags)
+		result.IdentityIds = expandIdentityIds(result.IdentityIds, diags)
+	}
+}
+
+func FlattenFromUserAssignedList(ctx context.Context, input *identity.UserAssignedList, result *typehelpers.ListNestedObjectValueOf[IdentityModel], diags *diag.Diagnostics) {
+	if input == nil {
+		r := typehelpers.NewListNestedObjectValueOfNull[IdentityModel](ctx)
+		*result = r
+
+		return
+	}
+
+	flat := IdentityModel{
+		IdentityIDs: typehelpers.NewSetValueOfNull[types.String](ctx),
+		// User Assigned Identities don't expose a Principal ID / Tenant ID at the top-level
+		PrincipalID: types.StringNull(),
+		TenantID:    types.StringNull(),
+	}
+
+	convert.Flatten(ctx, input, &flat, diags)
+	list, d := typehelpers.NewListNestedObjectValueOfValueSlice(ctx, []IdentityModel{flat})
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+
+	*result = list
+}
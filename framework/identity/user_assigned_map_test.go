@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/framework/identity"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	rmidentity "github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+func TestExpandUserAssignedMap(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	cases := []struct {
+		Name     string
+		Input    typehelpers.ListNestedObjectValueOf[identity.IdentityModel]
+		Expected *rmidentity.UserAssignedMap
+	}{
+		{
+			Name:  "null",
+			Input: typehelpers.NewListNestedObjectValueOfNull[identity.IdentityModel](ctx),
+			Expected: &rmidentity.UserAssignedMap{
+				Type:        rmidentity.TypeNone,
+				IdentityIds: nil,
+			},
+		},
+		{
+			Name: "UserAssigned",
+			Input: typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityModel{
+				{
+					Type:        types.StringValue(string(rmidentity.TypeUserAssigned)),
+					PrincipalID: types.StringNull(),
+					TenantID:    types.StringNull(),
+					IdentityIDs: typehelpers.NewSetValueOfMust[types.String](ctx, []attr.Value{
+						types.StringValue("100000-0000-0000-0000-000000000000"),
+						types.StringValue("100000-0000-0000-0000-000000000002"),
+					}),
+				},
+			}),
+			Expected: &rmidentity.UserAssignedMap{
+				Type: rmidentity.TypeUserAssigned,
+				IdentityIds: map[string]rmidentity.UserAssignedIdentityDetails{
+					"100000-0000-0000-0000-000000000000": {},
+					"100000-0000-0000-0000-000000000002": {},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		result := &rmidentity.UserAssignedMap{}
+		identity.ExpandToUserAssignedMap(ctx, tc.Input, result, &diags)
+
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("\nTesting: %s\nExpected: %+v\nGot: %+v\nDiags: %+v", tc.Name, tc.Expected, result, diags.Errors())
+		}
+	}
+}
+
+func TestFlattenUserAssignedMap(t *testing.T) {
+	ctx := context.Background()
+	diags := diag.Diagnostics{}
+
+	cases := []struct {
+		Name     string
+		Input    *rmidentity.UserAssignedMap
+		Expected typehelpers.ListNestedObjectValueOf[identity.IdentityModel]
+	}{
+		{
+			Name:     "null",
+			Input:    nil,
+			Expected: typehelpers.NewListNestedObjectValueOfNull[identity.IdentityModel](ctx),
+		},
+		{
+			Name: "explicit none",
+			Input: &rmidentity.UserAssignedMap{
+				Type:        rmidentity.TypeNone,
+				IdentityIds: map[string]rmidentity.UserAssignedIdentityDetails{},
+			},
+			Expected: typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityModel{
+				{
+					Type:        types.StringValue(string(rmidentity.TypeNone)),
+					PrincipalID: types.StringNull(),
+					TenantID:    types.StringNull(),
+					IdentityIDs: typehelpers.NewSetValueOfNull[types.String](ctx),
+				},
+			}),
+		},
+		{
+			Name: "UserAssigned",
+			Input: &rmidentity.UserAssignedMap{
+				Type: rmidentity.TypeUserAssigned,
+				IdentityIds: map[string]rmidentity.UserAssignedIdentityDetails{
+					"100000-0000-0000-0000-000000000000": {},
+					"100000-0000-0000-0000-000000000002": {},
+				},
+			},
+			Expected: typehelpers.NewListNestedObjectValueOfValueSliceMust(ctx, []identity.IdentityModel{
+				{
+					Type:        types.StringValue(string(rmidentity.TypeUserAssigned)),
+					PrincipalID: types.StringNull(),
+					TenantID:    types.StringNull(),
+					IdentityIDs: typehelpers.NewSetValueOfMust[types.String](ctx, []attr.Value{
+						types.StringValue("100000-0000-0000-0000-000000000000"),
+						types.StringValue("100000-0000-0000-0000-000000000002"),
+					}),
+				},
+			}),
+		},
+	}
+
+	for _, tc := range cases {
+		result := typehelpers.ListNestedObjectValueOf[identity.IdentityModel]{}
+		identity.FlattenFromUserAssignedMap(ctx, tc.Input, &result, &diags)
+
+		if !tc.Expected.Equal(result) {
+			t.Errorf("\nTesting: %s\nExpected: %+v\nGot: %+v\nDiags: %+v", tc.Name, tc.Expected, result, diags.Errors())
+		}
+	}
+}
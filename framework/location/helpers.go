@@ -4,23 +4,22 @@
 package location
 
 import (
-	"strings"
-
-	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-// Normalize transforms the human readable Azure Region/Location names (e.g. `West US`)
-// into the canonical value to allow comparisons between user-code and API Responses
+// Normalize transforms the human readable Azure Region/Location names (e.g. `West US`), display names
+// (e.g. `(US) West US`) and aliases (e.g. `indiasouth`) into the canonical value to allow comparisons
+// between user-code and API Responses - matching the value planned by casing.NormaliseLocationStringPlanModifier
 func Normalize(input string) string {
-	return strings.ReplaceAll(strings.ToLower(input), " ", "")
+	return location.NormalizeCanonical(input)
 }
 
 // NormalizeNilable normalizes the Location field even if it's nil to ensure this field
 // can always have a value
 func NormalizeNilable(input *string) string {
-	return Normalize(pointer.From(input))
+	return location.NormalizeCanonicalNilable(input)
 }
 
 // NormalizeValue returns a Framework compatible StringValue for the location
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// StringValidator returns a Framework String validator which validates the location against the
+// Locations supported by the provided LocationValidator
+func StringValidator(locationValidator *location.LocationValidator) validator.String {
+	return typehelpers.WrappedStringValidator{
+		Func: locationValidator.ValidateFunc(),
+		Desc: "validates that the value is an Azure Location supported by this Environment",
+	}
+}
@@ -3,7 +3,7 @@ package casing
 import (
 	"context"
 
-	"github.com/hashicorp/go-azure-helpers/framework/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -17,7 +17,7 @@ func NormaliseLocationStringPlanModifier() planmodifier.String {
 }
 
 func (i normaliseLocationStringPlanModifier) Description(_ context.Context) string {
-	return "Normalises Azure locations to their lowercase, zero white-space versions."
+	return "Normalises Azure locations to their canonical names, falling back to their lowercase, zero white-space versions when the location isn't known."
 }
 
 func (i normaliseLocationStringPlanModifier) MarkdownDescription(ctx context.Context) string {
@@ -25,5 +25,9 @@ func (i normaliseLocationStringPlanModifier) MarkdownDescription(ctx context.Con
 }
 
 func (i normaliseLocationStringPlanModifier) PlanModifyString(_ context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
-	response.PlanValue = types.StringValue(location.NormalizeNilable(request.PlanValue.ValueStringPointer()))
+	if request.PlanValue.IsUnknown() {
+		return
+	}
+
+	response.PlanValue = types.StringValue(location.NormalizeCanonicalNilable(request.PlanValue.ValueStringPointer()))
 }
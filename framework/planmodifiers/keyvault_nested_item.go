@@ -0,0 +1,53 @@
+package planmodifiers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+type keyVaultNestedItemVersionlessPlanModifier struct{}
+
+// KeyVaultNestedItemVersionlessStringPlanModifier suppresses the diff between a versionless Key Vault Nested Item ID
+// in the configuration and a versioned ID for the same Nested Item in the state. This allows a user to opt into using
+// the latest version of a Nested Item by specifying the versionless ID, without a diff being shown whenever the API
+// returns the versioned ID.
+//
+// NOTE: since the planned value can only differ from the configuration for Computed attributes, this must be
+// used with an Optional and Computed attribute - otherwise Terraform will reject the plan.
+func KeyVaultNestedItemVersionlessStringPlanModifier() planmodifier.String {
+	return keyVaultNestedItemVersionlessPlanModifier{}
+}
+
+var _ planmodifier.String = keyVaultNestedItemVersionlessPlanModifier{}
+
+func (k keyVaultNestedItemVersionlessPlanModifier) Description(_ context.Context) string {
+	return "suppresses the diff between a versionless Key Vault Nested Item ID in the configuration and a versioned ID for the same Nested Item in the state"
+}
+
+func (k keyVaultNestedItemVersionlessPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return k.Description(ctx)
+}
+
+func (k keyVaultNestedItemVersionlessPlanModifier) PlanModifyString(_ context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	if request.PlanValue.IsNull() || request.PlanValue.IsUnknown() || request.StateValue.IsNull() || request.StateValue.IsUnknown() {
+		return
+	}
+
+	planId, err := keyvault.ParseNestedItemID(request.PlanValue.ValueString(), keyvault.VersionTypeVersionless, keyvault.NestedItemTypeAny)
+	if err != nil {
+		// the user has either pinned a specific version or the value is invalid, either way the diff should be shown
+		return
+	}
+
+	stateId, err := keyvault.ParseNestedItemID(request.StateValue.ValueString(), keyvault.VersionTypeAny, keyvault.NestedItemTypeAny)
+	if err != nil {
+		return
+	}
+
+	if strings.EqualFold(planId.VersionlessID(), stateId.VersionlessID()) {
+		response.PlanValue = request.StateValue
+	}
+}
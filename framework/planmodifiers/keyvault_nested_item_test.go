@@ -0,0 +1,69 @@
+package planmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestKeyVaultNestedItemVersionlessStringPlanModifier(t *testing.T) {
+	versioned := "https://example.vault.azure.net/secrets/example/fdf067c93bbb4b22bff4d8b7a9a56217"
+
+	cases := map[string]struct {
+		plan     types.String
+		state    types.String
+		expected types.String
+	}{
+		"versionless-matches-state": {
+			plan:     types.StringValue("https://example.vault.azure.net/secrets/example"),
+			state:    types.StringValue(versioned),
+			expected: types.StringValue(versioned),
+		},
+		"versionless-different-casing": {
+			plan:     types.StringValue("https://EXAMPLE.vault.azure.net/secrets/example"),
+			state:    types.StringValue(versioned),
+			expected: types.StringValue(versioned),
+		},
+		"versionless-different-item": {
+			plan:     types.StringValue("https://example.vault.azure.net/secrets/other"),
+			state:    types.StringValue(versioned),
+			expected: types.StringValue("https://example.vault.azure.net/secrets/other"),
+		},
+		"versioned-plan": {
+			plan:     types.StringValue("https://example.vault.azure.net/secrets/example/00000000000000000000000000000000"),
+			state:    types.StringValue(versioned),
+			expected: types.StringValue("https://example.vault.azure.net/secrets/example/00000000000000000000000000000000"),
+		},
+		"unknown-plan": {
+			plan:     types.StringUnknown(),
+			state:    types.StringValue(versioned),
+			expected: types.StringUnknown(),
+		},
+		"null-state": {
+			plan:     types.StringValue("https://example.vault.azure.net/secrets/example"),
+			state:    types.StringNull(),
+			expected: types.StringValue("https://example.vault.azure.net/secrets/example"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				ConfigValue: tc.plan,
+				PlanValue:   tc.plan,
+				StateValue:  tc.state,
+			}
+			resp := planmodifier.StringResponse{
+				PlanValue: req.PlanValue,
+			}
+
+			KeyVaultNestedItemVersionlessStringPlanModifier().PlanModifyString(context.Background(), req, &resp)
+
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Fatalf("expected %s but got %s", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type tagsAllPlanModifier struct {
+	tagsPath path.Path
+	defaults func() map[string]string
+}
+
+var _ planmodifier.Map = &tagsAllPlanModifier{}
+
+// TagsAllPlanModifier computes the planned value for a `tags_all` attribute by merging the default Tags
+// (returned from `defaults`, typically configured at the Provider level) with the Tags in the attribute at
+// `tagsPath`, where the values from the Resource take precedence. The planned value is unknown when any of
+// the Tags aren't yet known.
+func TagsAllPlanModifier(tagsPath path.Path, defaults func() map[string]string) planmodifier.Map {
+	return &tagsAllPlanModifier{
+		tagsPath: tagsPath,
+		defaults: defaults,
+	}
+}
+
+func (t tagsAllPlanModifier) Description(_ context.Context) string {
+	return "computes the Tags for the Resource merged with the default Tags"
+}
+
+func (t tagsAllPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return t.Description(ctx)
+}
+
+func (t tagsAllPlanModifier) PlanModifyMap(ctx context.Context, request planmodifier.MapRequest, response *planmodifier.MapResponse) {
+	// the Resource is being destroyed
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	// the Tags may use a custom type (e.g. typehelpers.MapValueOf) so are retrieved as a MapValuable
+	var raw attr.Value
+	if d := request.Plan.GetAttribute(ctx, t.tagsPath, &raw); d.HasError() {
+		response.Diagnostics.Append(d...)
+		return
+	}
+	valuable, ok := raw.(basetypes.MapValuable)
+	if !ok {
+		response.Diagnostics.AddAttributeError(t.tagsPath, "Invalid Tags", fmt.Sprintf("expected the Tags to be a Map but got %T", raw))
+		return
+	}
+	configured, d := valuable.ToMapValue(ctx)
+	if d.HasError() {
+		response.Diagnostics.Append(d...)
+		return
+	}
+
+	if configured.IsUnknown() {
+		response.PlanValue = types.MapUnknown(types.StringType)
+		return
+	}
+
+	input := make(map[string]string)
+	for k, v := range configured.Elements() {
+		value, ok := v.(basetypes.StringValuable)
+		if !ok || v.IsUnknown() {
+			response.PlanValue = types.MapUnknown(types.StringType)
+			return
+		}
+		str, d := value.ToStringValue(ctx)
+		if d.HasError() {
+			response.Diagnostics.Append(d...)
+			return
+		}
+		input[k] = str.ValueString()
+	}
+
+	var defaults map[string]string
+	if t.defaults != nil {
+		defaults = t.defaults()
+	}
+
+	planned, d := types.MapValueFrom(ctx, types.StringType, tags.Merge(defaults, input))
+	if d.HasError() {
+		response.Diagnostics.Append(d...)
+		return
+	}
+
+	response.PlanValue = planned
+}
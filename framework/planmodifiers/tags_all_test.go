@@ -0,0 +1,160 @@
+package planmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTagsAllPlanModifier(t *testing.T) {
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"tags":     mapType,
+			"tags_all": mapType,
+		},
+	}
+	planWithTags := func(tags tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"tags":     tags,
+			"tags_all": tftypes.NewValue(mapType, tftypes.UnknownValue),
+		})
+	}
+	tagsValue := func(input map[string]string) tftypes.Value {
+		values := make(map[string]tftypes.Value)
+		for k, v := range input {
+			values[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(mapType, values)
+	}
+	tagsMap := func(input map[string]string) types.Map {
+		return types.MapValueMust(types.StringType, func() map[string]attr.Value {
+			values := make(map[string]attr.Value)
+			for k, v := range input {
+				values[k] = types.StringValue(v)
+			}
+			return values
+		}())
+	}
+	defaults := func() map[string]string {
+		return map[string]string{
+			"environment": "dev",
+			"team":        "networking",
+		}
+	}
+
+	cases := map[string]struct {
+		plan     tftypes.Value
+		defaults func() map[string]string
+		expected types.Map
+	}{
+		"destroy": {
+			plan:     tftypes.NewValue(objectType, nil),
+			defaults: defaults,
+			expected: types.MapUnknown(types.StringType),
+		},
+		"null-tags": {
+			plan:     planWithTags(tftypes.NewValue(mapType, nil)),
+			defaults: defaults,
+			expected: tagsMap(defaults()),
+		},
+		"unknown-tags": {
+			plan:     planWithTags(tftypes.NewValue(mapType, tftypes.UnknownValue)),
+			defaults: defaults,
+			expected: types.MapUnknown(types.StringType),
+		},
+		"unknown-tag-value": {
+			plan: planWithTags(tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"owner": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})),
+			defaults: defaults,
+			expected: types.MapUnknown(types.StringType),
+		},
+		"no-defaults": {
+			plan: planWithTags(tagsValue(map[string]string{
+				"owner": "example",
+			})),
+			defaults: nil,
+			expected: tagsMap(map[string]string{
+				"owner": "example",
+			}),
+		},
+		"merged-with-defaults": {
+			plan: planWithTags(tagsValue(map[string]string{
+				"owner": "example",
+			})),
+			defaults: defaults,
+			expected: tagsMap(map[string]string{
+				"environment": "dev",
+				"owner":       "example",
+				"team":        "networking",
+			}),
+		},
+		"overrides-defaults": {
+			plan: planWithTags(tagsValue(map[string]string{
+				"environment": "prod",
+			})),
+			defaults: defaults,
+			expected: tagsMap(map[string]string{
+				"environment": "prod",
+				"team":        "networking",
+			}),
+		},
+		"overrides-defaults-with-different-casing": {
+			plan: planWithTags(tagsValue(map[string]string{
+				"Environment": "prod",
+			})),
+			defaults: defaults,
+			expected: tagsMap(map[string]string{
+				"Environment": "prod",
+				"team":        "networking",
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.MapRequest{
+				Path:        path.Root("tags_all"),
+				ConfigValue: types.MapNull(types.StringType),
+				PlanValue:   types.MapUnknown(types.StringType),
+				StateValue:  types.MapNull(types.StringType),
+				Plan: tfsdk.Plan{
+					Schema: resourceSchema,
+					Raw:    tc.plan,
+				},
+			}
+			resp := planmodifier.MapResponse{
+				PlanValue: req.PlanValue,
+			}
+
+			TagsAllPlanModifier(path.Root("tags"), tc.defaults).PlanModifyMap(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %+v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Fatalf("expected %s but got %s", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+type tagsCaseInsensitiveKeysPlanModifier struct{}
+
+var _ planmodifier.Map = &tagsCaseInsensitiveKeysPlanModifier{}
+
+// TagsCaseInsensitiveKeysPlanModifier uses the value from the state when the planned Tags only differ from the
+// stored Tags by the casing of their keys, since Azure treats Tag keys case-insensitively.
+//
+// NOTE: since the planned value can only differ from the configuration for Computed attributes, this should
+// be used with an Optional and Computed attribute - otherwise the casing should be reconciled when flattening
+// the Tags, using commonschema.FlattenTagsWithConfiguredCasing.
+func TagsCaseInsensitiveKeysPlanModifier() planmodifier.Map {
+	return &tagsCaseInsensitiveKeysPlanModifier{}
+}
+
+func (t tagsCaseInsensitiveKeysPlanModifier) Description(_ context.Context) string {
+	return "suppresses the diff when the planned Tags only differ from the stored Tags by the casing of their keys"
+}
+
+func (t tagsCaseInsensitiveKeysPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return t.Description(ctx)
+}
+
+func (t tagsCaseInsensitiveKeysPlanModifier) PlanModifyMap(ctx context.Context, request planmodifier.MapRequest, response *planmodifier.MapResponse) {
+	if request.PlanValue.IsNull() || request.PlanValue.IsUnknown() || request.StateValue.IsNull() || request.StateValue.IsUnknown() {
+		return
+	}
+
+	planned := make(map[string]string)
+	if d := request.PlanValue.ElementsAs(ctx, &planned, false); d.HasError() {
+		// the Tags may contain unknown values, in which case they can't be compared
+		return
+	}
+
+	existing := make(map[string]string)
+	if d := request.StateValue.ElementsAs(ctx, &existing, false); d.HasError() {
+		return
+	}
+
+	if tags.EqualIgnoringKeyCasing(planned, existing) {
+		response.PlanValue = request.StateValue
+	}
+}
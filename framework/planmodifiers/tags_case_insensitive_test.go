@@ -0,0 +1,101 @@
+package planmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTagsCaseInsensitiveKeysPlanModifier(t *testing.T) {
+	tagsMap := func(input map[string]string) types.Map {
+		elements := make(map[string]attr.Value, len(input))
+		for k, v := range input {
+			elements[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	cases := map[string]struct {
+		plan     types.Map
+		state    types.Map
+		expected types.Map
+	}{
+		"same-tags": {
+			plan:     tagsMap(map[string]string{"environment": "prod"}),
+			state:    tagsMap(map[string]string{"environment": "prod"}),
+			expected: tagsMap(map[string]string{"environment": "prod"}),
+		},
+		"different-key-casing": {
+			plan:     tagsMap(map[string]string{"environment": "prod", "team": "networking"}),
+			state:    tagsMap(map[string]string{"Environment": "prod", "Team": "networking"}),
+			expected: tagsMap(map[string]string{"Environment": "prod", "Team": "networking"}),
+		},
+		"different-value": {
+			plan:     tagsMap(map[string]string{"environment": "prod"}),
+			state:    tagsMap(map[string]string{"Environment": "dev"}),
+			expected: tagsMap(map[string]string{"environment": "prod"}),
+		},
+		"different-value-casing": {
+			plan:     tagsMap(map[string]string{"environment": "Prod"}),
+			state:    tagsMap(map[string]string{"environment": "prod"}),
+			expected: tagsMap(map[string]string{"environment": "Prod"}),
+		},
+		"additional-tag": {
+			plan:     tagsMap(map[string]string{"environment": "prod", "team": "networking"}),
+			state:    tagsMap(map[string]string{"Environment": "prod"}),
+			expected: tagsMap(map[string]string{"environment": "prod", "team": "networking"}),
+		},
+		"removed-tag": {
+			plan:     tagsMap(map[string]string{"environment": "prod"}),
+			state:    tagsMap(map[string]string{"Environment": "prod", "Team": "networking"}),
+			expected: tagsMap(map[string]string{"environment": "prod"}),
+		},
+		"unknown-element": {
+			plan:     types.MapValueMust(types.StringType, map[string]attr.Value{"environment": types.StringUnknown()}),
+			state:    tagsMap(map[string]string{"Environment": "prod"}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{"environment": types.StringUnknown()}),
+		},
+		"unknown-plan": {
+			plan:     types.MapUnknown(types.StringType),
+			state:    tagsMap(map[string]string{"Environment": "prod"}),
+			expected: types.MapUnknown(types.StringType),
+		},
+		"null-plan": {
+			plan:     types.MapNull(types.StringType),
+			state:    tagsMap(map[string]string{"Environment": "prod"}),
+			expected: types.MapNull(types.StringType),
+		},
+		"unknown-state": {
+			plan:     tagsMap(map[string]string{"environment": "prod"}),
+			state:    types.MapUnknown(types.StringType),
+			expected: tagsMap(map[string]string{"environment": "prod"}),
+		},
+		"null-state": {
+			plan:     tagsMap(map[string]string{"environment": "prod"}),
+			state:    types.MapNull(types.StringType),
+			expected: tagsMap(map[string]string{"environment": "prod"}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.MapRequest{
+				ConfigValue: tc.plan,
+				PlanValue:   tc.plan,
+				StateValue:  tc.state,
+			}
+			resp := planmodifier.MapResponse{
+				PlanValue: req.PlanValue,
+			}
+
+			TagsCaseInsensitiveKeysPlanModifier().PlanModifyMap(context.Background(), req, &resp)
+
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Fatalf("expected %s but got %s", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+type zonesOrderInsensitivePlanModifier struct{}
+
+var _ planmodifier.List = &zonesOrderInsensitivePlanModifier{}
+
+// ZonesOrderInsensitiveListPlanModifier treats a List of Zones as a Set, using the value from the state when
+// the planned Zones only differ by ordering (or duplicates) to avoid a diff.
+//
+// NOTE: since the planned value can only differ from the configuration for Computed attributes, this should
+// be used with an Optional and Computed attribute - otherwise Terraform will raise an error as the planned
+// Zones don't match the configuration.
+func ZonesOrderInsensitiveListPlanModifier() planmodifier.List {
+	return &zonesOrderInsensitivePlanModifier{}
+}
+
+func (z zonesOrderInsensitivePlanModifier) Description(_ context.Context) string {
+	return "suppresses the diff when the planned Zones only differ from the stored Zones by their ordering"
+}
+
+func (z zonesOrderInsensitivePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return z.Description(ctx)
+}
+
+func (z zonesOrderInsensitivePlanModifier) PlanModifyList(ctx context.Context, request planmodifier.ListRequest, response *planmodifier.ListResponse) {
+	if request.PlanValue.IsNull() || request.PlanValue.IsUnknown() || request.StateValue.IsNull() || request.StateValue.IsUnknown() {
+		return
+	}
+
+	planned := make([]string, 0)
+	if d := request.PlanValue.ElementsAs(ctx, &planned, false); d.HasError() {
+		// the Zones may contain unknown values, in which case they can't be compared
+		return
+	}
+
+	existing := make([]string, 0)
+	if d := request.StateValue.ElementsAs(ctx, &existing, false); d.HasError() {
+		return
+	}
+
+	if zones.Zones(planned).Equal(existing) {
+		response.PlanValue = request.StateValue
+	}
+}
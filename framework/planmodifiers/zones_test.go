@@ -0,0 +1,86 @@
+package planmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestZonesOrderInsensitiveListPlanModifier(t *testing.T) {
+	zonesList := func(input ...string) types.List {
+		elements := make([]attr.Value, 0, len(input))
+		for _, v := range input {
+			elements = append(elements, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	cases := map[string]struct {
+		plan     types.List
+		state    types.List
+		expected types.List
+	}{
+		"same-order": {
+			plan:     zonesList("1", "2"),
+			state:    zonesList("1", "2"),
+			expected: zonesList("1", "2"),
+		},
+		"different-order": {
+			plan:     zonesList("3", "1", "2"),
+			state:    zonesList("1", "2", "3"),
+			expected: zonesList("1", "2", "3"),
+		},
+		"duplicates": {
+			plan:     zonesList("2", "1", "2"),
+			state:    zonesList("1", "2"),
+			expected: zonesList("1", "2"),
+		},
+		"different-zones": {
+			plan:     zonesList("1", "3"),
+			state:    zonesList("1", "2"),
+			expected: zonesList("1", "3"),
+		},
+		"additional-zone": {
+			plan:     zonesList("1", "2", "3"),
+			state:    zonesList("1", "2"),
+			expected: zonesList("1", "2", "3"),
+		},
+		"unknown-element": {
+			plan:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("1"), types.StringUnknown()}),
+			state:    zonesList("1", "2"),
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("1"), types.StringUnknown()}),
+		},
+		"unknown-plan": {
+			plan:     types.ListUnknown(types.StringType),
+			state:    zonesList("1", "2"),
+			expected: types.ListUnknown(types.StringType),
+		},
+		"null-state": {
+			plan:     zonesList("2", "1"),
+			state:    types.ListNull(types.StringType),
+			expected: zonesList("2", "1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.ListRequest{
+				ConfigValue: tc.plan,
+				PlanValue:   tc.plan,
+				StateValue:  tc.state,
+			}
+			resp := planmodifier.ListResponse{
+				PlanValue: req.PlanValue,
+			}
+
+			ZonesOrderInsensitiveListPlanModifier().PlanModifyList(context.Background(), req, &resp)
+
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Fatalf("expected %s but got %s", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type edgeZoneForLocation struct {
+	locationPath path.Path
+	validator    edgezones.Validator
+}
+
+var _ validator.String = &edgeZoneForLocation{}
+
+// EdgeZoneForLocation validates that the Edge Zone is attached to the Location specified in the attribute
+// at `locationPath`, using the provided edgezones.Validator
+func EdgeZoneForLocation(locationPath path.Path, validator edgezones.Validator) edgeZoneForLocation {
+	return edgeZoneForLocation{
+		locationPath: locationPath,
+		validator:    validator,
+	}
+}
+
+func (e edgeZoneForLocation) Description(ctx context.Context) string {
+	return "validates that the Edge Zone is attached to the Location"
+}
+
+func (e edgeZoneForLocation) MarkdownDescription(ctx context.Context) string {
+	return e.Description(ctx)
+}
+
+func (e edgeZoneForLocation) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var location types.String
+	if d := request.Config.GetAttribute(ctx, e.locationPath, &location); d.HasError() {
+		response.Diagnostics.Append(d...)
+		return
+	}
+	if location.IsNull() || location.IsUnknown() {
+		return
+	}
+
+	if err := e.validator.Validate(request.ConfigValue.ValueString(), location.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Edge Zone", err.Error())
+	}
+}
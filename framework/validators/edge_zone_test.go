@@ -0,0 +1,68 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEdgeZoneForLocation_ValidateString(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Required: true,
+			},
+			"edge_zone": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+	edgeZoneValidator := edgezones.NewValidatorFromCatalogue(edgezones.Catalogue{
+		Cloud:   "fixture",
+		Version: "2025-01-01",
+		EdgeZones: []edgezones.EdgeZone{
+			{
+				Name:           "fixtureedgezone1",
+				DisplayName:    "Fixture Edge Zone 1",
+				ParentLocation: "westus",
+			},
+		},
+	})
+
+	cases := map[string]bool{
+		"westus":  true,
+		"West US": true,
+		"eastus":  false,
+	}
+
+	for location, valid := range cases {
+		config := tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"location":  tftypes.NewValue(tftypes.String, location),
+				"edge_zone": tftypes.NewValue(tftypes.String, "fixtureedgezone1"),
+			}),
+		}
+
+		req := validator.StringRequest{
+			Path:        path.Root("edge_zone"),
+			Config:      config,
+			ConfigValue: types.StringValue("fixtureedgezone1"),
+		}
+		var resp validator.StringResponse
+
+		EdgeZoneForLocation(path.Root("location"), edgeZoneValidator).ValidateString(ctx, req, &resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("expected valid to be %t for %q but got %+v", valid, location, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type keyVaultNestedItemId struct {
+	versionType    keyvault.VersionType
+	nestedItemType keyvault.NestedItemType
+}
+
+var _ validator.String = &keyVaultNestedItemId{}
+
+var _ function.StringParameterValidator = &keyVaultNestedItemId{}
+
+// KeyVaultNestedItemID validates that the provided string is a Key Vault Nested Item ID matching the
+// provided `VersionType` and `NestedItemType` constants
+func KeyVaultNestedItemID(versionType keyvault.VersionType, nestedItemType keyvault.NestedItemType) keyVaultNestedItemId {
+	return keyVaultNestedItemId{
+		versionType:    versionType,
+		nestedItemType: nestedItemType,
+	}
+}
+
+func (k keyVaultNestedItemId) Description(ctx context.Context) string {
+	return "validates that the provided string is a Key Vault Nested Item ID"
+}
+
+func (k keyVaultNestedItemId) MarkdownDescription(ctx context.Context) string {
+	return k.Description(ctx)
+}
+
+func (k keyVaultNestedItemId) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := keyvault.ParseNestedItemID(request.ConfigValue.ValueString(), k.versionType, k.nestedItemType); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "ID validation error", err.Error())
+	}
+}
+
+func (k keyVaultNestedItemId) ValidateParameterString(_ context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	if _, err := keyvault.ParseNestedItemID(request.Value.ValueString(), k.versionType, k.nestedItemType); err != nil {
+		response.Error = validatorfuncerr.InvalidParameterValueFuncError(request.ArgumentPosition, "ID validation error", err.Error())
+	}
+}
+
+var keyVaultNestedItemNameRegex = regexp.MustCompile(`^[0-9a-zA-Z-]+$`)
+
+type keyVaultNestedItemName struct{}
+
+var _ validator.String = &keyVaultNestedItemName{}
+
+var _ function.StringParameterValidator = &keyVaultNestedItemName{}
+
+// KeyVaultNestedItemName validates that the provided string is a valid name for a Key Vault Nested Item
+func KeyVaultNestedItemName() keyVaultNestedItemName {
+	return keyVaultNestedItemName{}
+}
+
+func (k keyVaultNestedItemName) Description(ctx context.Context) string {
+	return "validates that the provided string is a valid Key Vault Nested Item name"
+}
+
+func (k keyVaultNestedItemName) MarkdownDescription(ctx context.Context) string {
+	return k.Description(ctx)
+}
+
+func (k keyVaultNestedItemName) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, err := range validateKeyVaultNestedItemName(request.ConfigValue.ValueString()) {
+		response.Diagnostics.AddAttributeError(request.Path, "name validation error", err)
+	}
+}
+
+func (k keyVaultNestedItemName) ValidateParameterString(_ context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	if errs := validateKeyVaultNestedItemName(request.Value.ValueString()); len(errs) > 0 {
+		response.Error = validatorfuncerr.InvalidParameterValueFuncError(request.ArgumentPosition, "name validation error", errs[0])
+	}
+}
+
+func validateKeyVaultNestedItemName(input string) []string {
+	errs := make([]string, 0)
+
+	if l := len(input); l < 1 || l > 127 {
+		errs = append(errs, fmt.Sprintf("must be between 1 and 127 characters in length, got %d", l))
+	}
+
+	if !keyVaultNestedItemNameRegex.MatchString(input) {
+		errs = append(errs, "may only contain alphanumeric characters and dashes")
+	}
+
+	return errs
+}
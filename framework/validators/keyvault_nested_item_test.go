@@ -0,0 +1,129 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestKeyVaultNestedItemId_ValidateString(t *testing.T) {
+	cases := []struct {
+		Input          string
+		VersionType    keyvault.VersionType
+		NestedItemType keyvault.NestedItemType
+		ExpectError    bool
+	}{
+		{
+			Input:          "",
+			VersionType:    keyvault.VersionTypeAny,
+			NestedItemType: keyvault.NestedItemTypeAny,
+			ExpectError:    true,
+		},
+		{
+			Input:          "https://my-keyvault.vault.azure.net/secrets/bird/fdf067c93bbb4b22bff4d8b7a9a56217",
+			VersionType:    keyvault.VersionTypeAny,
+			NestedItemType: keyvault.NestedItemTypeAny,
+			ExpectError:    false,
+		},
+		{
+			Input:          "https://my-keyvault.vault.azure.net/secrets/bird",
+			VersionType:    keyvault.VersionTypeVersionless,
+			NestedItemType: keyvault.NestedItemTypeSecret,
+			ExpectError:    false,
+		},
+		{
+			Input:          "https://my-keyvault.vault.azure.net/secrets/bird/fdf067c93bbb4b22bff4d8b7a9a56217",
+			VersionType:    keyvault.VersionTypeVersionless,
+			NestedItemType: keyvault.NestedItemTypeSecret,
+			ExpectError:    true,
+		},
+		{
+			Input:          "https://my-keyvault.vault.azure.net/secrets/bird",
+			VersionType:    keyvault.VersionTypeVersioned,
+			NestedItemType: keyvault.NestedItemTypeSecret,
+			ExpectError:    true,
+		},
+		{
+			Input:          "https://my-keyvault.vault.azure.net/keys/castle/1492",
+			VersionType:    keyvault.VersionTypeAny,
+			NestedItemType: keyvault.NestedItemTypeSecret,
+			ExpectError:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		v := KeyVaultNestedItemID(tc.VersionType, tc.NestedItemType)
+
+		req := validator.StringRequest{
+			ConfigValue: types.StringValue(tc.Input),
+		}
+		var resp validator.StringResponse
+
+		v.ValidateString(context.Background(), req, &resp)
+
+		if hasErr := resp.Diagnostics.HasError(); hasErr != tc.ExpectError {
+			t.Fatalf("%s: expected error to be %t but got %t: %+v", tc.Input, tc.ExpectError, hasErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestKeyVaultNestedItemId_ValidateString_Unknown(t *testing.T) {
+	v := KeyVaultNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeAny)
+	req := validator.StringRequest{ConfigValue: types.StringUnknown()}
+	var resp validator.StringResponse
+	v.ValidateString(context.Background(), req, &resp)
+	if len(resp.Diagnostics) != 0 {
+		t.Fatalf("expected no diagnostics for unknown, got %d", resp.Diagnostics.ErrorsCount())
+	}
+}
+
+func TestKeyVaultNestedItemId_ValidateParameterString(t *testing.T) {
+	v := KeyVaultNestedItemID(keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+
+	req := function.StringParameterValidatorRequest{
+		ArgumentPosition: 1,
+		Value:            types.StringValue("https://my-keyvault.vault.azure.net/keys/castle"),
+	}
+	var resp function.StringParameterValidatorResponse
+
+	v.ValidateParameterString(context.Background(), req, &resp)
+
+	if resp.Error == nil {
+		t.Fatalf("expected function error for versionless ID, got none")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != req.ArgumentPosition {
+		t.Fatalf("expected function argument position %d in error, got %#v", req.ArgumentPosition, resp.Error.FunctionArgument)
+	}
+}
+
+func TestKeyVaultNestedItemName_ValidateString(t *testing.T) {
+	cases := map[string]bool{
+		"":                       true,
+		"hello-world":            false,
+		"Hello-World-123":        false,
+		"hello_world":            true,
+		"hello.world":            true,
+		strings.Repeat("a", 127): false,
+		strings.Repeat("a", 128): true,
+	}
+
+	for input, expectError := range cases {
+		v := KeyVaultNestedItemName()
+
+		req := validator.StringRequest{
+			ConfigValue: types.StringValue(input),
+		}
+		var resp validator.StringResponse
+
+		v.ValidateString(context.Background(), req, &resp)
+
+		if hasErr := resp.Diagnostics.HasError(); hasErr != expectError {
+			t.Fatalf("%q: expected error to be %t but got %t: %+v", input, expectError, hasErr, resp.Diagnostics)
+		}
+	}
+}
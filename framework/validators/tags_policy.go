@@ -0,0 +1,67 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type tagsPolicy struct {
+	policy tags.Policy
+}
+
+var _ validator.Map = &tagsPolicy{}
+
+// TagsPolicy validates that the Tags conform to the provided tags.Policy, adding a diagnostic scoped
+// to the Tag key for each violation. Tags whose values aren't yet known have their keys validated.
+func TagsPolicy(policy tags.Policy) tagsPolicy {
+	return tagsPolicy{
+		policy: policy,
+	}
+}
+
+func (t tagsPolicy) Description(ctx context.Context) string {
+	return "validates that the Tags conform to the Tag Policy"
+}
+
+func (t tagsPolicy) MarkdownDescription(ctx context.Context) string {
+	return t.Description(ctx)
+}
+
+func (t tagsPolicy) ValidateMap(ctx context.Context, request validator.MapRequest, response *validator.MapResponse) {
+	if request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := request.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+	values := make(map[string]string, len(elements))
+	for key, element := range elements {
+		keys = append(keys, key)
+
+		v, ok := element.(basetypes.StringValuable)
+		if !ok {
+			continue
+		}
+		value, diags := v.ToStringValue(ctx)
+		if diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			continue
+		}
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		values[key] = value.ValueString()
+	}
+
+	for _, violation := range t.policy.EvaluatePartial(keys, values) {
+		if violation.Key == "" {
+			response.Diagnostics.AddAttributeError(request.Path, violation.Summary, violation.Detail)
+			continue
+		}
+
+		response.Diagnostics.AddAttributeError(request.Path.AtMapKey(violation.Key), violation.Summary, violation.Detail)
+	}
+}
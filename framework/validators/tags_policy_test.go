@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTagsPolicy_ValidateMap(t *testing.T) {
+	policy := tags.DefaultPolicy()
+	policy.RequiredKeys = []string{"environment"}
+	policy.ValuePatterns["environment"] = regexp.MustCompile(`^(dev|test|prod)$`)
+
+	cases := map[string]struct {
+		input         types.Map
+		expectedPaths []path.Path
+	}{
+		"valid": {
+			input: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"environment": types.StringValue("prod"),
+			}),
+		},
+		"unknown": {
+			input: types.MapUnknown(types.StringType),
+		},
+		"missing-required": {
+			input: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"team": types.StringValue("networking"),
+			}),
+			expectedPaths: []path.Path{
+				path.Root("tags"),
+			},
+		},
+		"unknown-value": {
+			input: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"environment": types.StringUnknown(),
+				"azure-owner": types.StringValue("example"),
+			}),
+			expectedPaths: []path.Path{
+				path.Root("tags").AtMapKey("azure-owner"),
+			},
+		},
+		"invalid-value": {
+			input: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"environment": types.StringValue("staging"),
+			}),
+			expectedPaths: []path.Path{
+				path.Root("tags").AtMapKey("environment"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.MapRequest{
+				Path:        path.Root("tags"),
+				ConfigValue: tc.input,
+			}
+			var resp validator.MapResponse
+
+			TagsPolicy(policy).ValidateMap(context.Background(), req, &resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tc.expectedPaths) {
+				t.Fatalf("expected %d errors but got %d: %+v", len(tc.expectedPaths), len(errs), resp.Diagnostics)
+			}
+			for i, expected := range tc.expectedPaths {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(expected) {
+					t.Fatalf("expected the error to be scoped to %s but got %+v", expected, errs[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type tagsUniqueKeysCaseInsensitive struct{}
+
+var _ validator.Map = &tagsUniqueKeysCaseInsensitive{}
+
+// TagsUniqueKeysCaseInsensitive validates that the Tags don't contain multiple keys which only differ by casing,
+// since Azure treats Tag keys case-insensitively
+func TagsUniqueKeysCaseInsensitive() tagsUniqueKeysCaseInsensitive {
+	return tagsUniqueKeysCaseInsensitive{}
+}
+
+func (t tagsUniqueKeysCaseInsensitive) Description(ctx context.Context) string {
+	return "validates that the Tag keys are unique when compared case-insensitively"
+}
+
+func (t tagsUniqueKeysCaseInsensitive) MarkdownDescription(ctx context.Context) string {
+	return t.Description(ctx)
+}
+
+func (t tagsUniqueKeysCaseInsensitive) ValidateMap(ctx context.Context, request validator.MapRequest, response *validator.MapResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// only the keys are compared, so the values can be unknown
+	keys := make(map[string]string)
+	for k := range request.ConfigValue.Elements() {
+		keys[k] = ""
+	}
+
+	for _, duplicates := range tags.CaseInsensitiveDuplicateKeys(keys) {
+		for _, key := range duplicates {
+			response.Diagnostics.AddAttributeError(request.Path.AtMapKey(key), "Duplicate Tag Key", fmt.Sprintf("the tag keys %q must be unique, but only differ by casing", duplicates))
+		}
+	}
+}
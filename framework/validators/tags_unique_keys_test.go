@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTagsUniqueKeysCaseInsensitive_ValidateMap(t *testing.T) {
+	cases := map[string]struct {
+		input          types.Map
+		expectedErrors int
+	}{
+		"null": {
+			input: types.MapNull(types.StringType),
+		},
+		"unique": {
+			input: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"environment": types.StringValue("prod"),
+				"team":        types.StringUnknown(),
+			}),
+		},
+		"duplicate": {
+			input: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"environment": types.StringValue("prod"),
+				"Environment": types.StringUnknown(),
+			}),
+			expectedErrors: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.MapRequest{
+				Path:        path.Root("tags"),
+				ConfigValue: tc.input,
+			}
+			var resp validator.MapResponse
+
+			TagsUniqueKeysCaseInsensitive().ValidateMap(context.Background(), req, &resp)
+
+			if count := resp.Diagnostics.ErrorsCount(); count != tc.expectedErrors {
+				t.Fatalf("expected %d errors but got %d: %+v", tc.expectedErrors, count, resp.Diagnostics)
+			}
+		})
+	}
+}
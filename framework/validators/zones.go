@@ -0,0 +1,90 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type zonesForLocation struct {
+	locationPath path.Path
+	validator    zones.Validator
+}
+
+var _ validator.String = &zonesForLocation{}
+
+var _ validator.Set = &zonesForLocation{}
+
+var _ validator.List = &zonesForLocation{}
+
+// ZonesForLocation validates that the Zone(s) are available within the Location specified in the
+// attribute at `locationPath`, using the provided zones.Validator. This can be used for a single
+// Zone (a String) or multiple Zones (a Set or List of Strings).
+func ZonesForLocation(locationPath path.Path, validator zones.Validator) zonesForLocation {
+	return zonesForLocation{
+		locationPath: locationPath,
+		validator:    validator,
+	}
+}
+
+func (z zonesForLocation) Description(ctx context.Context) string {
+	return "validates that the Zones are available within the Location"
+}
+
+func (z zonesForLocation) MarkdownDescription(ctx context.Context) string {
+	return z.Description(ctx)
+}
+
+func (z zonesForLocation) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	z.validate(ctx, request.Config, request.Path, request.ConfigValue, &response.Diagnostics)
+}
+
+func (z zonesForLocation) ValidateSet(ctx context.Context, request validator.SetRequest, response *validator.SetResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, v := range request.ConfigValue.Elements() {
+		z.validate(ctx, request.Config, request.Path.AtSetValue(v), v, &response.Diagnostics)
+	}
+}
+
+func (z zonesForLocation) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, v := range request.ConfigValue.Elements() {
+		z.validate(ctx, request.Config, request.Path.AtListIndex(i), v, &response.Diagnostics)
+	}
+}
+
+func (z zonesForLocation) validate(ctx context.Context, config tfsdk.Config, zonePath path.Path, value attr.Value, diags *diag.Diagnostics) {
+	zone, ok := value.(types.String)
+	if !ok || zone.IsNull() || zone.IsUnknown() {
+		return
+	}
+
+	var location types.String
+	if d := config.GetAttribute(ctx, z.locationPath, &location); d.HasError() {
+		diags.Append(d...)
+		return
+	}
+	if location.IsNull() || location.IsUnknown() {
+		return
+	}
+
+	if err := z.validator.Validate(zone.ValueString(), location.ValueString()); err != nil {
+		diags.AddAttributeError(zonePath, "Invalid Zone", err.Error())
+	}
+}
@@ -0,0 +1,183 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func zonesFixtureValidator() zones.Validator {
+	return zones.NewValidatorFromCatalogue(zonesFixtureCatalogue())
+}
+
+func zonesFixtureCatalogue() location.Catalogue {
+	return location.Catalogue{
+		Cloud:   "fixture",
+		Version: "2025-01-01",
+		Regions: []location.Region{
+			{
+				Name:                      "twozones",
+				DisplayName:               "Two Zones",
+				Geography:                 "Fixture",
+				SupportsAvailabilityZones: true,
+				PhysicalZoneCount:         2,
+			},
+			{
+				Name:        "nozones",
+				DisplayName: "No Zones",
+				Geography:   "Fixture",
+			},
+		},
+	}
+}
+
+func TestZonesForLocation_ValidateSet(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Required: true,
+			},
+			"zones": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	cases := []struct {
+		location string
+		zones    []string
+		errors   int
+	}{
+		{
+			location: "twozones",
+			zones:    []string{"1", "2"},
+			errors:   0,
+		},
+		{
+			location: "twozones",
+			zones:    []string{"1", "3", "4"},
+			errors:   2,
+		},
+		{
+			location: "nozones",
+			zones:    []string{"1"},
+			errors:   1,
+		},
+		{
+			location: "unknown",
+			zones:    []string{"9"},
+			errors:   0,
+		},
+	}
+
+	for _, c := range cases {
+		elements := make([]attr.Value, 0)
+		rawElements := make([]tftypes.Value, 0)
+		for _, v := range c.zones {
+			elements = append(elements, types.StringValue(v))
+			rawElements = append(rawElements, tftypes.NewValue(tftypes.String, v))
+		}
+
+		config := tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"location": tftypes.NewValue(tftypes.String, c.location),
+				"zones":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, rawElements),
+			}),
+		}
+
+		req := validator.SetRequest{
+			Path:        path.Root("zones"),
+			Config:      config,
+			ConfigValue: types.SetValueMust(types.StringType, elements),
+		}
+		var resp validator.SetResponse
+
+		ZonesForLocation(path.Root("location"), zonesFixtureValidator()).ValidateSet(ctx, req, &resp)
+
+		if len(resp.Diagnostics.Errors()) != c.errors {
+			t.Errorf("expected %d errors for %+v in %q but got %+v", c.errors, c.zones, c.location, resp.Diagnostics)
+		}
+	}
+}
+
+func TestZonesForLocation_ValidateString(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Required: true,
+			},
+			"zone": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	config := tfsdk.Config{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"location": tftypes.NewValue(tftypes.String, "twozones"),
+			"zone":     tftypes.NewValue(tftypes.String, "3"),
+		}),
+	}
+
+	req := validator.StringRequest{
+		Path:        path.Root("zone"),
+		Config:      config,
+		ConfigValue: types.StringValue("3"),
+	}
+	var resp validator.StringResponse
+
+	ZonesForLocation(path.Root("location"), zonesFixtureValidator()).ValidateString(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error for Zone 3 in a Location with 2 Zones")
+	}
+}
+
+func TestZonesForLocation_Authoritative(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Required: true,
+			},
+			"zone": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	config := tfsdk.Config{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"location": tftypes.NewValue(tftypes.String, "unknown"),
+			"zone":     tftypes.NewValue(tftypes.String, "1"),
+		}),
+	}
+
+	req := validator.StringRequest{
+		Path:        path.Root("zone"),
+		Config:      config,
+		ConfigValue: types.StringValue("1"),
+	}
+	var resp validator.StringResponse
+
+	ZonesForLocation(path.Root("location"), zones.NewAuthoritativeValidator(zonesFixtureCatalogue())).ValidateString(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error for an unknown Location when using an authoritative Catalogue")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package catalogueloader
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Loader lazily loads an embedded Catalogue of type T for each Cloud, from the files
+// `catalogue/{cloud}.json` within an embed.FS. This is used for both the Location Catalogue and
+// the Catalogues built on top of it (e.g. Edge Zones).
+type Loader[C ~string, T any] struct {
+	files  embed.FS
+	kind   string
+	clouds []C
+
+	// cloud returns the Cloud which the loaded Catalogue describes
+	cloud func(T) C
+
+	// sort sorts the loaded Catalogue so that lookups are deterministic
+	sort func(*T)
+
+	once       sync.Once
+	catalogues map[C]T
+	err        error
+}
+
+// New returns a Loader for the Catalogues for each of the `clouds` within `files`, where `kind`
+// describes the Catalogue in error messages (e.g. `Location`), `cloud` returns the Cloud a Catalogue
+// describes and `sort` sorts a Catalogue once it's been loaded
+func New[C ~string, T any](files embed.FS, kind string, clouds []C, cloud func(T) C, sort func(*T)) *Loader[C, T] {
+	return &Loader[C, T]{
+		files:  files,
+		kind:   kind,
+		clouds: clouds,
+		cloud:  cloud,
+		sort:   sort,
+	}
+}
+
+// CatalogueForCloud returns the embedded Catalogue for the specified Cloud, the Catalogues for every
+// Cloud are loaded (and validated) the first time this is called
+func (l *Loader[C, T]) CatalogueForCloud(cloud C) (*T, error) {
+	l.once.Do(func() {
+		l.catalogues, l.err = l.load()
+	})
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	catalogue, ok := l.catalogues[cloud]
+	if !ok {
+		return nil, fmt.Errorf("no %s Catalogue is available for the Cloud %q", l.kind, string(cloud))
+	}
+
+	return &catalogue, nil
+}
+
+func (l *Loader[C, T]) load() (map[C]T, error) {
+	out := make(map[C]T)
+	for _, cloud := range l.clouds {
+		contents, err := l.files.ReadFile(fmt.Sprintf("catalogue/%s.json", string(cloud)))
+		if err != nil {
+			return nil, fmt.Errorf("reading the %s Catalogue for the Cloud %q: %+v", l.kind, string(cloud), err)
+		}
+
+		var catalogue T
+		if err := json.Unmarshal(contents, &catalogue); err != nil {
+			return nil, fmt.Errorf("unmarshaling the %s Catalogue for the Cloud %q: %+v", l.kind, string(cloud), err)
+		}
+		if actual := l.cloud(catalogue); actual != cloud {
+			return nil, fmt.Errorf("the %s Catalogue for the Cloud %q describes the Cloud %q", l.kind, string(cloud), string(actual))
+		}
+
+		if l.sort != nil {
+			l.sort(&catalogue)
+		}
+		out[cloud] = catalogue
+	}
+
+	return out, nil
+}
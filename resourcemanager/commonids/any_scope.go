@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &AnyScopeId{}
+
+// AnyScopeId is a struct representing the Resource ID for a Scope which is known to be one of a
+// Tenant, Management Group, Subscription, Resource Group or Resource.
+//
+// Unlike ScopeId (which accepts any value), parsing an AnyScopeId validates that the Scope is one of these.
+type AnyScopeId struct {
+	Scope string
+}
+
+// NewAnyScopeID returns a new AnyScopeId struct
+func NewAnyScopeID(scope string) AnyScopeId {
+	return AnyScopeId{
+		Scope: scope,
+	}
+}
+
+// ParseAnyScopeID parses 'input' into an AnyScopeId
+func ParseAnyScopeID(input string) (*AnyScopeId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&AnyScopeId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := AnyScopeId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseAnyScopeIDInsensitively parses 'input' case-insensitively into an AnyScopeId
+// note: this method should only be used for API response data and not user input
+func ParseAnyScopeIDInsensitively(input string) (*AnyScopeId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&AnyScopeId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := AnyScopeId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *AnyScopeId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.Scope, ok = input.Parsed["scope"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+
+	if id.Kind() == ScopeKindUnknown {
+		return fmt.Errorf("expected %q to be one of the Scope Kinds %s", id.Scope, strings.Join(PossibleValuesForScopeKind(), ", "))
+	}
+
+	return nil
+}
+
+// ValidateAnyScopeID checks that 'input' can be parsed as an Any Scope ID
+func ValidateAnyScopeID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAnyScopeID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ValidateAnyScopeIDOfKind returns a validation function which checks that 'input' can be parsed as an
+// Any Scope ID of one of the specified Scope Kinds
+func ValidateAnyScopeIDOfKind(kinds ...ScopeKind) func(input interface{}, key string) (warnings []string, errors []error) {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		v, ok := input.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+			return
+		}
+
+		id, err := ParseAnyScopeID(v)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+
+		kind := id.Kind()
+		for _, k := range kinds {
+			if k == kind {
+				return
+			}
+		}
+
+		allowed := make([]string, 0, len(kinds))
+		for _, k := range kinds {
+			allowed = append(allowed, string(k))
+		}
+		errors = append(errors, fmt.Errorf("expected %q to be a Scope of Kind %s but got %s", key, strings.Join(allowed, ", "), kind))
+		return
+	}
+}
+
+// Kind returns the ScopeKind for this Scope
+func (id AnyScopeId) Kind() ScopeKind {
+	return scopeKindForID(id.Scope)
+}
+
+// ID returns the formatted Any Scope ID
+func (id AnyScopeId) ID() string {
+	fmtString := "/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"))
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Any Scope ID
+func (id AnyScopeId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+	}
+}
+
+// String returns a human-readable description of this Any Scope ID
+func (id AnyScopeId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Kind: %q", string(id.Kind())),
+	}
+	return fmt.Sprintf("Any Scope (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &AnyScopeId{}
+
+func TestNewAnyScopeID(t *testing.T) {
+	id := NewAnyScopeID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group")
+
+	if id.Scope != "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group" {
+		t.Fatalf("Expected %q but got %q for Segment 'Scope'", id.Scope, "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group")
+	}
+}
+
+func TestFormatAnyScopeID(t *testing.T) {
+	actual := NewAnyScopeID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
+	}
+}
+
+func TestParseAnyScopeID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected ScopeKind
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Tenant
+			Input:    "/",
+			Expected: ScopeKindTenant,
+		},
+		{
+			// Management Group
+			Input:    "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: ScopeKindManagementGroup,
+		},
+		{
+			// Subscription
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: ScopeKindSubscription,
+		},
+		{
+			// Resource Group
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group",
+			Expected: ScopeKindResourceGroup,
+		},
+		{
+			// Resource
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group/providers/Microsoft.Compute/virtualMachines/vm1",
+			Expected: ScopeKindResource,
+		},
+		{
+			// Nested Resource
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: ScopeKindResource,
+		},
+		{
+			// Extension Resource on a Resource
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			Expected: ScopeKindResource,
+		},
+		{
+			// Resource within a Subscription
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/definition1",
+			Expected: ScopeKindResource,
+		},
+		{
+			// Resource within a Management Group
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Expected: ScopeKindResource,
+		},
+		{
+			// Resource within the Tenant
+			Input:    "/providers/Microsoft.Authorization/roleDefinitions/definition1",
+			Expected: ScopeKindResource,
+		},
+		{
+			// Incomplete Resource Group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups",
+			Error: true,
+		},
+		{
+			// Incomplete Resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group/providers/Microsoft.Compute/virtualMachines",
+			Error: true,
+		},
+		{
+			// Unknown Parent Scope
+			Input: "/blah/providers/Microsoft.Compute/virtualMachines/vm1",
+			Error: true,
+		},
+		{
+			// Trailing Slash
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+		{
+			// Not a Resource ID
+			Input: "subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseAnyScopeID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Input {
+			t.Fatalf("Expected %q but got %q for Scope", v.Input, actual.Scope)
+		}
+
+		if actual.Kind() != v.Expected {
+			t.Fatalf("Expected %q but got %q for Kind", v.Expected, actual.Kind())
+		}
+
+		if scopeKind := NewScopeID(v.Input).Kind(); scopeKind != v.Expected {
+			t.Fatalf("Expected %q but got %q for ScopeId.Kind", v.Expected, scopeKind)
+		}
+	}
+}
+
+func TestScopeIdKindInsensitively(t *testing.T) {
+	testData := map[string]ScopeKind{
+		"/PROVIDERS/microsoft.management/MANAGEMENTGROUPS/group1":                                                           ScopeKindManagementGroup,
+		"/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012":                                                               ScopeKindSubscription,
+		"/sUbScRiPtIoNs/12345678-1234-9876-4563-123456789012/rEsOuRcEgRoUpS/group1":                                         ScopeKindResourceGroup,
+		"/sUbScRiPtIoNs/12345678-1234-9876-4563-123456789012/PROVIDERS/Microsoft.Authorization/roleDefinitions/definition1": ScopeKindResource,
+		"/blah": ScopeKindUnknown,
+	}
+	for input, expected := range testData {
+		if actual := NewScopeID(input).Kind(); actual != expected {
+			t.Fatalf("Expected %q but got %q for %q", expected, actual, input)
+		}
+	}
+}
+
+func TestValidateAnyScopeIDOfKind(t *testing.T) {
+	validate := ValidateAnyScopeIDOfKind(ScopeKindManagementGroup, ScopeKindSubscription)
+
+	testData := map[string]bool{
+		"/": true,
+		"/providers/Microsoft.Management/managementGroups/group1":                                false,
+		"/subscriptions/12345678-1234-9876-4563-123456789012":                                    false,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group": true,
+		"/blah": true,
+	}
+	for input, expectError := range testData {
+		_, errs := validate(input, "scope")
+		if hasErr := len(errs) > 0; hasErr != expectError {
+			t.Fatalf("Expected an error to be %t but got %t for %q: %+v", expectError, hasErr, input, errs)
+		}
+	}
+}
+
+func TestSegmentsForAnyScopeId(t *testing.T) {
+	segments := AnyScopeId{}.Segments()
+	if len(segments) == 0 {
+		t.Fatalf("AnyScopeId has no segments")
+	}
+
+	uniqueNames := make(map[string]struct{}, 0)
+	for _, segment := range segments {
+		uniqueNames[segment.Name] = struct{}{}
+	}
+	if len(uniqueNames) != len(segments) {
+		t.Fatalf("Expected the Segments to be unique but got \"%d\" unique segments and %d total segments", len(uniqueNames), len(segments))
+	}
+}
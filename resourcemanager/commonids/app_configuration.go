@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &AppConfigurationId{}
+
+// AppConfigurationId is a struct representing the Resource ID for an App Configuration
+type AppConfigurationId struct {
+	SubscriptionId         string
+	ResourceGroupName      string
+	ConfigurationStoreName string
+}
+
+// NewAppConfigurationID returns a new AppConfigurationId struct
+func NewAppConfigurationID(subscriptionId string, resourceGroupName string, configurationStoreName string) AppConfigurationId {
+	return AppConfigurationId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroupName:      resourceGroupName,
+		ConfigurationStoreName: configurationStoreName,
+	}
+}
+
+// ParseAppConfigurationID parses 'input' into a AppConfigurationId
+func ParseAppConfigurationID(input string) (*AppConfigurationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&AppConfigurationId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := AppConfigurationId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseAppConfigurationIDInsensitively parses 'input' case-insensitively into a AppConfigurationId
+// note: this method should only be used for API response data and not user input
+func ParseAppConfigurationIDInsensitively(input string) (*AppConfigurationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&AppConfigurationId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := AppConfigurationId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *AppConfigurationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ConfigurationStoreName, ok = input.Parsed["configurationStoreName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "configurationStoreName", input)
+	}
+
+	return nil
+}
+
+// ValidateAppConfigurationID checks that 'input' can be parsed as an App Configuration ID
+func ValidateAppConfigurationID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAppConfigurationID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted App Configuration ID
+func (id AppConfigurationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppConfiguration/configurationStores/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ConfigurationStoreName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this App Configuration ID
+func (id AppConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppConfiguration", "Microsoft.AppConfiguration", "Microsoft.AppConfiguration"),
+		resourceids.StaticSegment("staticConfigurationStores", "configurationStores", "configurationStores"),
+		resourceids.UserSpecifiedSegment("configurationStoreName", "configurationStoreValue"),
+	}
+}
+
+// String returns a human-readable description of this App Configuration ID
+func (id AppConfigurationId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("App Configuration Name: %q", id.ConfigurationStoreName),
+	}
+	return fmt.Sprintf("App Configuration (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &ApplicationInsightsId{}
+
+// ApplicationInsightsId is a struct representing the Resource ID for an Application Insights
+type ApplicationInsightsId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ComponentName     string
+}
+
+// NewApplicationInsightsID returns a new ApplicationInsightsId struct
+func NewApplicationInsightsID(subscriptionId string, resourceGroupName string, componentName string) ApplicationInsightsId {
+	return ApplicationInsightsId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ComponentName:     componentName,
+	}
+}
+
+// ParseApplicationInsightsID parses 'input' into a ApplicationInsightsId
+func ParseApplicationInsightsID(input string) (*ApplicationInsightsId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&ApplicationInsightsId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := ApplicationInsightsId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseApplicationInsightsIDInsensitively parses 'input' case-insensitively into a ApplicationInsightsId
+// note: this method should only be used for API response data and not user input
+func ParseApplicationInsightsIDInsensitively(input string) (*ApplicationInsightsId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&ApplicationInsightsId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := ApplicationInsightsId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *ApplicationInsightsId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ComponentName, ok = input.Parsed["componentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "componentName", input)
+	}
+
+	return nil
+}
+
+// ValidateApplicationInsightsID checks that 'input' can be parsed as an Application Insights ID
+func ValidateApplicationInsightsID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseApplicationInsightsID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Application Insights ID
+func (id ApplicationInsightsId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/components/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ComponentName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Application Insights ID
+func (id ApplicationInsightsId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftInsights", "Microsoft.Insights", "Microsoft.Insights"),
+		resourceids.StaticSegment("staticComponents", "components", "components"),
+		resourceids.UserSpecifiedSegment("componentName", "componentValue"),
+	}
+}
+
+// String returns a human-readable description of this Application Insights ID
+func (id ApplicationInsightsId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Application Insights Name: %q", id.ComponentName),
+	}
+	return fmt.Sprintf("Application Insights (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &BillingAccountId{}
+
+// BillingAccountId is a struct representing the Resource ID for a Billing Account
+type BillingAccountId struct {
+	BillingAccountName string
+}
+
+// NewBillingAccountID returns a new BillingAccountId struct
+func NewBillingAccountID(billingAccountName string) BillingAccountId {
+	return BillingAccountId{
+		BillingAccountName: billingAccountName,
+	}
+}
+
+// ParseBillingAccountID parses 'input' into a BillingAccountId
+func ParseBillingAccountID(input string) (*BillingAccountId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&BillingAccountId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := BillingAccountId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseBillingAccountIDInsensitively parses 'input' case-insensitively into a BillingAccountId
+// note: this method should only be used for API response data and not user input
+func ParseBillingAccountIDInsensitively(input string) (*BillingAccountId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&BillingAccountId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := BillingAccountId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *BillingAccountId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.BillingAccountName, ok = input.Parsed["billingAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "billingAccountName", input)
+	}
+
+	return nil
+}
+
+// ValidateBillingAccountID checks that 'input' can be parsed as a Billing Account ID
+func ValidateBillingAccountID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseBillingAccountID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Billing Account ID
+func (id BillingAccountId) ID() string {
+	fmtString := "/providers/Microsoft.Billing/billingAccounts/%s"
+	return fmt.Sprintf(fmtString, id.BillingAccountName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Billing Account ID
+func (id BillingAccountId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("providers", "providers", "providers"),
+		resourceids.ResourceProviderSegment("resourceProvider", "Microsoft.Billing", "Microsoft.Billing"),
+		resourceids.StaticSegment("billingAccounts", "billingAccounts", "billingAccounts"),
+		resourceids.UserSpecifiedSegment("billingAccountName", "billingAccountValue"),
+	}
+}
+
+// String returns a human-readable description of this Billing Account ID
+func (id BillingAccountId) String() string {
+	components := []string{
+		fmt.Sprintf("Billing Account Name: %q", id.BillingAccountName),
+	}
+	return fmt.Sprintf("Billing Account (%s)", strings.Join(components, "\n"))
+}
@@ -164,30 +164,18 @@ const (
 	WebChatBotServiceChannelType          BotServiceChannelType = "WebChatChannel"
 )
 
+// NormalizeBotServiceChannelType returns the canonical casing of `input` when it matches a known
+// BotServiceChannelType case-insensitively, otherwise `input` is returned unmodified
+func NormalizeBotServiceChannelType(input string) BotServiceChannelType {
+	out, _ := parseBotServiceChannelType(input)
+	return *out
+}
+
+// parseBotServiceChannelType normalises `input` to the canonical casing of a known BotServiceChannelType
 func parseBotServiceChannelType(input string) (*BotServiceChannelType, error) {
-	vals := map[string]BotServiceChannelType{
-		"AcsChatChannel":          AcsChatBotServiceChannelType,
-		"AlexaChannel":            AlexaBotServiceChannelType,
-		"DirectLineChannel":       DirectLineBotServiceChannelType,
-		"DirectLineSpeechChannel": DirectLineSpeechBotServiceChannelType,
-		"EmailChannel":            EmailBotServiceChannelType,
-		"KikChannel":              KikChannelBotServiceChannelType,
-		"FacebookChannel":         FacebookBotServiceChannelType,
-		"LineChannel":             LineBotServiceChannelType,
-		"M365Extensions":          M365ExtensionsBotServiceChannelType,
-		"MsTeamsChannel":          MsTeamsBotServiceChannelType,
-		"Omnichannel":             OmniChannelBotServiceChannelType,
-		"OutlookChannel":          OutlookBotServiceChannelType,
-		"SearchAssistant":         SearchAssistantBotServiceChannelType,
-		"SkypeChannel":            SkypeBotServiceChannelType,
-		"SlackChannel":            SlackBotServiceChannelType,
-		"SmsChannel":              SmsBotServiceChannelType,
-		"TelegramChannel":         TelegramBotServiceChannelType,
-		"TelephonyChannel":        TelephonyBotServiceChannelType,
-		"WebChatChannel":          WebChatBotServiceChannelType,
-	}
-	if v, ok := vals[strings.ToLower(input)]; ok {
-		return &v, nil
+	if v, ok := resourceids.NormalizeConstantValue(input, PossibleValuesForBotServiceChannelType()); ok {
+		out := BotServiceChannelType(v)
+		return &out, nil
 	}
 
 	// otherwise presume it's an undefined value and best-effort it
@@ -195,6 +183,7 @@ func parseBotServiceChannelType(input string) (*BotServiceChannelType, error) {
 	return &out, nil
 }
 
+// PossibleValuesForBotServiceChannelType returns a string slice of possible "BotServiceChannelType" values
 func PossibleValuesForBotServiceChannelType() []string {
 	return []string{
 		string(AcsChatBotServiceChannelType),
@@ -294,6 +294,44 @@ func TestParseBotServiceChannelIDInsensitively(t *testing.T) {
 	}
 }
 
+func TestParseBotServiceChannelIDInsensitivelyNormalisesChannelType(t *testing.T) {
+	actual, err := ParseBotServiceChannelIDInsensitively("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.BotService/botServices/botServiceValue/channels/mstEAMSchannel")
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %+v", err)
+	}
+
+	if actual.ChannelType != MsTeamsBotServiceChannelType {
+		t.Fatalf("Expected %q but got %q for ChannelType", MsTeamsBotServiceChannelType, actual.ChannelType)
+	}
+}
+
+func TestNormalizeBotServiceChannelType(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected BotServiceChannelType
+	}{
+		{
+			Input:    "EmailChannel",
+			Expected: EmailBotServiceChannelType,
+		},
+		{
+			Input:    "emailchannel",
+			Expected: EmailBotServiceChannelType,
+		},
+		{
+			Input:    "SOMENEWCHANNEL",
+			Expected: "SOMENEWCHANNEL",
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := NormalizeBotServiceChannelType(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
 func TestSegmentsForBotServiceChannelId(t *testing.T) {
 	segments := BotServiceChannelId{}.Segments()
 	if len(segments) == 0 {
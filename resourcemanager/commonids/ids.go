@@ -9,21 +9,30 @@ import (
 
 func CommonIds() []resourceids.ResourceId {
 	return []resourceids.ResourceId{
+		&AnyScopeId{},
+		&AppConfigurationId{},
+		&ApplicationInsightsId{},
 		&AppServiceId{},
 		&AppServiceEnvironmentId{},
 		&AppServicePlanId{},
 		&AutomationCompilationJobId{},
 		&AvailabilitySetId{},
+		&BillingAccountId{},
+		&BillingProfileId{},
 		&BotServiceId{},
 		&BotServiceChannelId{},
 		&ChaosStudioCapabilityId{},
 		&ChaosStudioTargetId{},
 		&CloudServicesIPConfigurationId{},
 		&CloudServicesPublicIPAddressId{},
+		&ContainerRegistryId{},
+		&CosmosDBAccountId{},
+		&CustomLocationId{},
 		&DedicatedHostId{},
 		&DedicatedHostGroupId{},
 		&DevCenterId{},
 		&DiskEncryptionSetId{},
+		&EventHubNamespaceId{},
 		&ExpressRouteCircuitPeeringId{},
 		&HDInsightClusterId{},
 		&HyperVSiteJobId{},
@@ -37,14 +46,22 @@ func CommonIds() []resourceids.ResourceId {
 		&KubernetesFleetId{},
 		&KustoClusterId{},
 		&KustoDatabaseId{},
+		&LogAnalyticsWorkspaceId{},
 		&ManagedDiskId{},
+		&ManagedHSMId{},
 		&ManagementGroupId{},
 		&NetworkInterfaceId{},
 		&NetworkInterfaceIPConfigurationId{},
 		&NetworkInterfaceId{},
+		&NetworkSecurityGroupId{},
+		&PrivateDNSZoneId{},
+		&PrivateEndpointId{},
 		&ProvisioningServiceId{},
 		&PublicIPAddressId{},
 		&ResourceGroupId{},
+		&RouteTableId{},
+		&ScopedRoleDefinitionId{},
+		&ServiceBusNamespaceId{},
 		&SharedImageGalleryId{},
 		&SpringCloudServiceId{},
 		&SqlDatabaseId{},
@@ -56,6 +73,7 @@ func CommonIds() []resourceids.ResourceId {
 		&StorageContainerId{},
 		&SubnetId{},
 		&SubscriptionId{},
+		&TenantScopeId{},
 		&UserAssignedIdentityId{},
 		&VirtualHubBGPConnectionId{},
 		&VirtualHubIPConfigurationId{},
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &LogAnalyticsWorkspaceId{}
+
+// LogAnalyticsWorkspaceId is a struct representing the Resource ID for a Log Analytics Workspace
+type LogAnalyticsWorkspaceId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkspaceName     string
+}
+
+// NewLogAnalyticsWorkspaceID returns a new LogAnalyticsWorkspaceId struct
+func NewLogAnalyticsWorkspaceID(subscriptionId string, resourceGroupName string, workspaceName string) LogAnalyticsWorkspaceId {
+	return LogAnalyticsWorkspaceId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkspaceName:     workspaceName,
+	}
+}
+
+// ParseLogAnalyticsWorkspaceID parses 'input' into a LogAnalyticsWorkspaceId
+func ParseLogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&LogAnalyticsWorkspaceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := LogAnalyticsWorkspaceId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseLogAnalyticsWorkspaceIDInsensitively parses 'input' case-insensitively into a LogAnalyticsWorkspaceId
+// note: this method should only be used for API response data and not user input
+func ParseLogAnalyticsWorkspaceIDInsensitively(input string) (*LogAnalyticsWorkspaceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&LogAnalyticsWorkspaceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := LogAnalyticsWorkspaceId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *LogAnalyticsWorkspaceId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	return nil
+}
+
+// ValidateLogAnalyticsWorkspaceID checks that 'input' can be parsed as a Log Analytics Workspace ID
+func ValidateLogAnalyticsWorkspaceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseLogAnalyticsWorkspaceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Log Analytics Workspace ID
+func (id LogAnalyticsWorkspaceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Log Analytics Workspace ID
+func (id LogAnalyticsWorkspaceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights", "Microsoft.OperationalInsights"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspaceValue"),
+	}
+}
+
+// String returns a human-readable description of this Log Analytics Workspace ID
+func (id LogAnalyticsWorkspaceId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Log Analytics Workspace Name: %q", id.WorkspaceName),
+	}
+	return fmt.Sprintf("Log Analytics Workspace (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &ManagedHSMId{}
+
+// ManagedHSMId is a struct representing the Resource ID for a Managed HSM
+type ManagedHSMId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ManagedHSMName    string
+}
+
+// NewManagedHSMID returns a new ManagedHSMId struct
+func NewManagedHSMID(subscriptionId string, resourceGroupName string, managedHSMName string) ManagedHSMId {
+	return ManagedHSMId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ManagedHSMName:    managedHSMName,
+	}
+}
+
+// ParseManagedHSMID parses 'input' into a ManagedHSMId
+func ParseManagedHSMID(input string) (*ManagedHSMId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&ManagedHSMId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := ManagedHSMId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseManagedHSMIDInsensitively parses 'input' case-insensitively into a ManagedHSMId
+// note: this method should only be used for API response data and not user input
+func ParseManagedHSMIDInsensitively(input string) (*ManagedHSMId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&ManagedHSMId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := ManagedHSMId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *ManagedHSMId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ManagedHSMName, ok = input.Parsed["managedHSMName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managedHSMName", input)
+	}
+
+	return nil
+}
+
+// ValidateManagedHSMID checks that 'input' can be parsed as a Managed HSM ID
+func ValidateManagedHSMID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseManagedHSMID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Managed HSM ID
+func (id ManagedHSMId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/managedHSMs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ManagedHSMName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Managed HSM ID
+func (id ManagedHSMId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKeyVault", "Microsoft.KeyVault", "Microsoft.KeyVault"),
+		resourceids.StaticSegment("staticManagedHSMs", "managedHSMs", "managedHSMs"),
+		resourceids.UserSpecifiedSegment("managedHSMName", "managedHSMValue"),
+	}
+}
+
+// String returns a human-readable description of this Managed HSM ID
+func (id ManagedHSMId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Managed HSM Name: %q", id.ManagedHSMName),
+	}
+	return fmt.Sprintf("Managed HSM (%s)", strings.Join(components, "\n"))
+}
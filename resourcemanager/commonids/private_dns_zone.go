@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &PrivateDNSZoneId{}
+
+// PrivateDNSZoneId is a struct representing the Resource ID for a Private DNS Zone
+type PrivateDNSZoneId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	PrivateDnsZoneName string
+}
+
+// NewPrivateDNSZoneID returns a new PrivateDNSZoneId struct
+func NewPrivateDNSZoneID(subscriptionId string, resourceGroupName string, privateDnsZoneName string) PrivateDNSZoneId {
+	return PrivateDNSZoneId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		PrivateDnsZoneName: privateDnsZoneName,
+	}
+}
+
+// ParsePrivateDNSZoneID parses 'input' into a PrivateDNSZoneId
+func ParsePrivateDNSZoneID(input string) (*PrivateDNSZoneId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&PrivateDNSZoneId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := PrivateDNSZoneId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParsePrivateDNSZoneIDInsensitively parses 'input' case-insensitively into a PrivateDNSZoneId
+// note: this method should only be used for API response data and not user input
+func ParsePrivateDNSZoneIDInsensitively(input string) (*PrivateDNSZoneId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&PrivateDNSZoneId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := PrivateDNSZoneId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *PrivateDNSZoneId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.PrivateDnsZoneName, ok = input.Parsed["privateDnsZoneName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "privateDnsZoneName", input)
+	}
+
+	return nil
+}
+
+// ValidatePrivateDNSZoneID checks that 'input' can be parsed as a Private DNS Zone ID
+func ValidatePrivateDNSZoneID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParsePrivateDNSZoneID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Private DNS Zone ID
+func (id PrivateDNSZoneId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Private DNS Zone ID
+func (id PrivateDNSZoneId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticPrivateDnsZones", "privateDnsZones", "privateDnsZones"),
+		resourceids.UserSpecifiedSegment("privateDnsZoneName", "privateDnsZoneValue"),
+	}
+}
+
+// String returns a human-readable description of this Private DNS Zone ID
+func (id PrivateDNSZoneId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Private DNS Zone Name: %q", id.PrivateDnsZoneName),
+	}
+	return fmt.Sprintf("Private DNS Zone (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"strings"
+)
+
+// ScopeKind describes the level of the Azure Resource Manager hierarchy that a Scope refers to
+type ScopeKind string
+
+const (
+	ScopeKindUnknown         ScopeKind = "Unknown"
+	ScopeKindTenant          ScopeKind = "Tenant"
+	ScopeKindManagementGroup ScopeKind = "ManagementGroup"
+	ScopeKindSubscription    ScopeKind = "Subscription"
+	ScopeKindResourceGroup   ScopeKind = "ResourceGroup"
+	ScopeKindResource        ScopeKind = "Resource"
+)
+
+// PossibleValuesForScopeKind returns a string slice of the known "ScopeKind" values
+func PossibleValuesForScopeKind() []string {
+	return []string{
+		string(ScopeKindTenant),
+		string(ScopeKindManagementGroup),
+		string(ScopeKindSubscription),
+		string(ScopeKindResourceGroup),
+		string(ScopeKindResource),
+	}
+}
+
+// Kind returns the ScopeKind for this Scope, or ScopeKindUnknown if it's not a known Azure Resource Manager Scope
+func (id ScopeId) Kind() ScopeKind {
+	return scopeKindForID(id.Scope)
+}
+
+// scopeKindForID classifies `input` (case-insensitively) into one of the known ScopeKinds
+func scopeKindForID(input string) ScopeKind {
+	if input == "/" {
+		return ScopeKindTenant
+	}
+
+	if !strings.HasPrefix(input, "/") || strings.HasSuffix(input, "/") {
+		return ScopeKindUnknown
+	}
+
+	if _, err := ParseManagementGroupIDInsensitively(input); err == nil {
+		return ScopeKindManagementGroup
+	}
+	if _, err := ParseSubscriptionIDInsensitively(input); err == nil {
+		return ScopeKindSubscription
+	}
+	if _, err := ParseResourceGroupIDInsensitively(input); err == nil {
+		return ScopeKindResourceGroup
+	}
+
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return ScopeKindUnknown
+		}
+	}
+
+	// a Resource is a Resource Provider namespace followed by one or more type/name pairs, beneath
+	// another scope - as such we split on the last `providers` segment and check the parent scope,
+	// which also accounts for extension resources (e.g. a Resource nested beneath another Resource)
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !strings.EqualFold(segments[i], "providers") {
+			continue
+		}
+
+		remaining := segments[i+1:]
+		if len(remaining) < 3 || (len(remaining)-1)%2 != 0 {
+			return ScopeKindUnknown
+		}
+
+		parent := "/" + strings.Join(segments[:i], "/")
+		if parentKind := scopeKindForID(parent); parentKind != ScopeKindUnknown {
+			return ScopeKindResource
+		}
+
+		return ScopeKindUnknown
+	}
+
+	return ScopeKindUnknown
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &TenantScopeId{}
+
+// TenantScopeId is a struct representing the Resource ID for the Tenant (Root) Scope, which is `/`
+type TenantScopeId struct{}
+
+// NewTenantScopeID returns a new TenantScopeId struct
+func NewTenantScopeID() TenantScopeId {
+	return TenantScopeId{}
+}
+
+// ParseTenantScopeID parses 'input' into a TenantScopeId
+func ParseTenantScopeID(input string) (*TenantScopeId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&TenantScopeId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := TenantScopeId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseTenantScopeIDInsensitively parses 'input' case-insensitively into a TenantScopeId
+// note: this method should only be used for API response data and not user input
+func ParseTenantScopeIDInsensitively(input string) (*TenantScopeId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&TenantScopeId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := TenantScopeId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *TenantScopeId) FromParseResult(input resourceids.ParseResult) error {
+	scope, ok := input.Parsed["scope"]
+	if !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+
+	if scope != "/" {
+		return fmt.Errorf("expected the Tenant Scope to be %q but got %q", "/", scope)
+	}
+
+	return nil
+}
+
+// ValidateTenantScopeID checks that 'input' can be parsed as a Tenant Scope ID
+func ValidateTenantScopeID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseTenantScopeID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Tenant Scope ID
+func (id TenantScopeId) ID() string {
+	return "/"
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Tenant Scope ID
+func (id TenantScopeId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/"),
+	}
+}
+
+// String returns a human-readable description of this Tenant Scope ID
+func (id TenantScopeId) String() string {
+	return fmt.Sprintf("Tenant Scope (%q)", id.ID())
+}
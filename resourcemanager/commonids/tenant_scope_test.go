@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonids
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &TenantScopeId{}
+
+func TestFormatTenantScopeID(t *testing.T) {
+	actual := NewTenantScopeID().ID()
+	expected := "/"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
+	}
+}
+
+func TestParseTenantScopeID(t *testing.T) {
+	testData := []struct {
+		Input string
+		Error bool
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/",
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/subscriptions",
+			Error: true,
+		},
+		{
+			// Invalid (Management Group)
+			Input: "/providers/Microsoft.Management/managementGroups/group1",
+			Error: true,
+		},
+		{
+			// Invalid (Subscription)
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, err := ParseTenantScopeID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+	}
+}
+
+func TestSegmentsForTenantScopeId(t *testing.T) {
+	segments := TenantScopeId{}.Segments()
+	if len(segments) == 0 {
+		t.Fatalf("TenantScopeId has no segments")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonschema
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/extendedlocation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ExtendedLocationComputed returns the schema for an Extended Location which is Computed
+func ExtendedLocationComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// ExtendedLocationOptional returns the schema for an Extended Location which is Optional
+func ExtendedLocationOptional() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     extendedLocationResource(false),
+	}
+}
+
+// ExtendedLocationOptionalForceNew returns the schema for an Extended Location which is both Optional and ForceNew
+func ExtendedLocationOptionalForceNew() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem:     extendedLocationResource(true),
+	}
+}
+
+func extendedLocationResource(forceNew bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         forceNew,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: extendedlocation.NameDiffSuppressFunc,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     forceNew,
+				ValidateFunc: validation.StringInSlice(extendedlocation.PossibleValuesForType(), false),
+			},
+		},
+	}
+}
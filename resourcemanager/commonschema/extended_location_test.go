@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testCustomLocationId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.ExtendedLocation/customLocations/example"
+
+func TestExtendedLocationOptionalValidation(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"extended_location": ExtendedLocationOptional(),
+		},
+	}
+
+	testData := []struct {
+		name  string
+		input map[string]interface{}
+		valid bool
+	}{
+		{
+			name:  "edge zone",
+			input: map[string]interface{}{"name": "microsoftlosangeles1", "type": "EdgeZone"},
+			valid: true,
+		},
+		{
+			name:  "custom location",
+			input: map[string]interface{}{"name": testCustomLocationId, "type": "CustomLocation"},
+			valid: true,
+		},
+		{
+			name:  "empty name",
+			input: map[string]interface{}{"name": "", "type": "EdgeZone"},
+			valid: false,
+		},
+		{
+			name:  "unknown type",
+			input: map[string]interface{}{"name": "example", "type": "Borg"},
+			valid: false,
+		},
+		{
+			name:  "type with different casing",
+			input: map[string]interface{}{"name": "microsoftlosangeles1", "type": "edgezone"},
+			valid: false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		diags := resource.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"extended_location": []interface{}{v.input},
+		}))
+		if v.valid != !diags.HasError() {
+			t.Fatalf("expected valid to be %t but got %+v", v.valid, diags)
+		}
+	}
+}
+
+func TestExtendedLocationOptionalForceNewDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"extended_location": ExtendedLocationOptionalForceNew(),
+		},
+	}
+
+	testData := []struct {
+		name         string
+		existingName string
+		existingType string
+		configName   string
+		configType   string
+		requiresNew  bool
+	}{
+		{
+			name:         "edge zone - same name",
+			existingName: "microsoftlosangeles1",
+			existingType: "EdgeZone",
+			configName:   "microsoftlosangeles1",
+			configType:   "EdgeZone",
+			requiresNew:  false,
+		},
+		{
+			name:         "edge zone - different casing and spacing",
+			existingName: "microsoftlosangeles1",
+			existingType: "EdgeZone",
+			configName:   "Microsoft Los Angeles 1",
+			configType:   "EdgeZone",
+			requiresNew:  false,
+		},
+		{
+			name:         "edge zone - different name",
+			existingName: "microsoftlosangeles1",
+			existingType: "EdgeZone",
+			configName:   "attatlanta1",
+			configType:   "EdgeZone",
+			requiresNew:  true,
+		},
+		{
+			name:         "custom location - different casing",
+			existingName: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example-resource-group/providers/microsoft.extendedlocation/customlocations/example",
+			existingType: "CustomLocation",
+			configName:   testCustomLocationId,
+			configType:   "CustomLocation",
+			requiresNew:  false,
+		},
+		{
+			// spacing is only normalised for Edge Zones
+			name:         "arc zone - different spacing",
+			existingName: "examplezone1",
+			existingType: "ArcZone",
+			configName:   "example zone 1",
+			configType:   "ArcZone",
+			requiresNew:  true,
+		},
+		{
+			name:         "different type",
+			existingName: "microsoftlosangeles1",
+			existingType: "EdgeZone",
+			configName:   "microsoftlosangeles1",
+			configType:   "ArcZone",
+			requiresNew:  true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		state := &terraform.InstanceState{
+			ID: "example",
+			Attributes: map[string]string{
+				"id":                       "example",
+				"extended_location.#":      "1",
+				"extended_location.0.name": v.existingName,
+				"extended_location.0.type": v.existingType,
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"extended_location": []interface{}{
+				map[string]interface{}{
+					"name": v.configName,
+					"type": v.configType,
+				},
+			},
+		})
+
+		diff, err := resource.Diff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+		if v.requiresNew != diff.RequiresNew() {
+			t.Fatalf("expected requiresNew to be %t but got %t: %+v", v.requiresNew, diff.RequiresNew(), diff)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonschema
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// IdentitySchemaBehaviour specifies whether the Identity schema returned from IdentitySchema is Required, Optional or Computed
+type IdentitySchemaBehaviour string
+
+const (
+	IdentitySchemaRequired IdentitySchemaBehaviour = "Required"
+	IdentitySchemaOptional IdentitySchemaBehaviour = "Optional"
+	IdentitySchemaComputed IdentitySchemaBehaviour = "Computed"
+)
+
+// IdentitySchemaOptions configures the Identity schema returned from IdentitySchema
+type IdentitySchemaOptions struct {
+	// Behaviour specifies whether the `identity` block is Required, Optional or Computed
+	Behaviour IdentitySchemaBehaviour
+
+	// ForceNew specifies whether changing the `identity` block should recreate the resource, this
+	// is ignored when the Behaviour is Computed
+	ForceNew bool
+}
+
+// IdentitySchema returns an Identity schema supporting the Types specified in `validTypes`, omitting this
+// will result in "UserAssigned", "SystemAssigned" and "SystemAssigned, UserAssigned" being valid.
+//
+// The `identity_ids` field is only present when a User Assigned Identity is supported (and is Required when
+// every supported Type includes a User Assigned Identity), and the `principal_id` and `tenant_id` fields are
+// only present when a System Assigned Identity is supported. The schema can be expanded and flattened using
+// identity.ExpandIdentity and identity.FlattenIdentity - which must be given the same `validTypes`.
+func IdentitySchema(options IdentitySchemaOptions, validTypes ...identity.Type) *schema.Schema {
+	if len(validTypes) == 0 {
+		validTypes = []identity.Type{
+			identity.TypeUserAssigned,
+			identity.TypeSystemAssigned,
+			identity.TypeSystemAssignedUserAssigned,
+		}
+	}
+
+	supportsSystemAssigned, supportsUserAssigned := identity.SchemaSupports(validTypes...)
+	identityIdsRequired := true
+	for _, v := range validTypes {
+		if v != identity.TypeUserAssigned && v != identity.TypeSystemAssignedUserAssigned {
+			identityIdsRequired = false
+		}
+	}
+
+	if options.Behaviour == IdentitySchemaComputed {
+		return identityComputedSchema(supportsSystemAssigned, supportsUserAssigned)
+	}
+
+	types := make([]string, 0)
+	for _, v := range validTypes {
+		types = append(types, string(v))
+	}
+
+	fields := map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     options.ForceNew,
+			ValidateFunc: validation.StringInSlice(types, false),
+		},
+	}
+	if supportsUserAssigned {
+		fields["identity_ids"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Required: identityIdsRequired,
+			Optional: !identityIdsRequired,
+			ForceNew: options.ForceNew,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
+		}
+	}
+	if supportsSystemAssigned {
+		fields["principal_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		fields["tenant_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: options.Behaviour == IdentitySchemaRequired,
+		Optional: options.Behaviour != IdentitySchemaRequired,
+		ForceNew: options.ForceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func identityComputedSchema(supportsSystemAssigned, supportsUserAssigned bool) *schema.Schema {
+	fields := map[string]*schema.Schema{
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	if supportsUserAssigned {
+		fields["identity_ids"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+	if supportsSystemAssigned {
+		fields["principal_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		fields["tenant_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
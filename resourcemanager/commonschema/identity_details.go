@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NOTE: the `user_assigned_identities` field exposes the Client ID and Principal ID of each User Assigned Identity
+// and can only be populated for the Map types, using the `FlattenXXXMapWithDetails` functions in the `identity` package.
+
+// UserAssignedIdentityDetailsComputed returns the schema for the Computed `user_assigned_identities` field within
+// an Identity block
+func UserAssignedIdentityDetailsComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"client_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// WithUserAssignedIdentityDetails adds the Computed `user_assigned_identities` field to the Identity schema `input`,
+// for example `WithUserAssignedIdentityDetails(SystemAssignedUserAssignedIdentityOptional())`
+func WithUserAssignedIdentityDetails(input *schema.Schema) *schema.Schema {
+	if resource, ok := input.Elem.(*schema.Resource); ok {
+		resource.Schema["user_assigned_identities"] = UserAssignedIdentityDetailsComputed()
+	}
+
+	return input
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commonschema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIdentitySchemaSetFlattened(t *testing.T) {
+	userAssignedIdentityId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first"
+	input := &identity.Identity{
+		Type:        identity.TypeSystemAssignedUserAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+		UserAssignedIdentities: []identity.UserAssignedIdentity{
+			{
+				ResourceId: userAssignedIdentityId,
+			},
+		},
+	}
+
+	testData := []struct {
+		name       string
+		behaviour  IdentitySchemaBehaviour
+		validTypes []identity.Type
+		expected   map[string]interface{}
+	}{
+		{
+			name:      "default",
+			behaviour: IdentitySchemaOptional,
+			expected: map[string]interface{}{
+				"type":         "SystemAssigned, UserAssigned",
+				"identity_ids": []interface{}{userAssignedIdentityId},
+				"principal_id": "11111111-1111-1111-1111-111111111111",
+				"tenant_id":    "22222222-2222-2222-2222-222222222222",
+			},
+		},
+		{
+			name:       "system assigned",
+			behaviour:  IdentitySchemaOptional,
+			validTypes: []identity.Type{identity.TypeSystemAssigned},
+			expected: map[string]interface{}{
+				"type":         "SystemAssigned, UserAssigned",
+				"principal_id": "11111111-1111-1111-1111-111111111111",
+				"tenant_id":    "22222222-2222-2222-2222-222222222222",
+			},
+		},
+		{
+			name:       "user assigned",
+			behaviour:  IdentitySchemaRequired,
+			validTypes: []identity.Type{identity.TypeUserAssigned},
+			expected: map[string]interface{}{
+				"type":         "SystemAssigned, UserAssigned",
+				"identity_ids": []interface{}{userAssignedIdentityId},
+			},
+		},
+		{
+			name:       "system and user assigned",
+			behaviour:  IdentitySchemaOptional,
+			validTypes: []identity.Type{identity.TypeSystemAssignedUserAssigned},
+			expected: map[string]interface{}{
+				"type":         "SystemAssigned, UserAssigned",
+				"identity_ids": []interface{}{userAssignedIdentityId},
+				"principal_id": "11111111-1111-1111-1111-111111111111",
+				"tenant_id":    "22222222-2222-2222-2222-222222222222",
+			},
+		},
+		{
+			name:       "computed user assigned",
+			behaviour:  IdentitySchemaComputed,
+			validTypes: []identity.Type{identity.TypeUserAssigned},
+			expected: map[string]interface{}{
+				"type":         "SystemAssigned, UserAssigned",
+				"identity_ids": []interface{}{userAssignedIdentityId},
+			},
+		},
+		{
+			name:       "computed system assigned",
+			behaviour:  IdentitySchemaComputed,
+			validTypes: []identity.Type{identity.TypeSystemAssigned},
+			expected: map[string]interface{}{
+				"type":         "SystemAssigned, UserAssigned",
+				"principal_id": "11111111-1111-1111-1111-111111111111",
+				"tenant_id":    "22222222-2222-2222-2222-222222222222",
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		s := map[string]*schema.Schema{
+			"identity": IdentitySchema(IdentitySchemaOptions{Behaviour: v.behaviour}, v.validTypes...),
+		}
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+
+		flattened, err := identity.FlattenIdentity(input, v.validTypes...)
+		if err != nil {
+			t.Fatalf("flattening identity: %+v", err)
+		}
+		if err := d.Set("identity", flattened); err != nil {
+			t.Fatalf("setting identity: %+v", err)
+		}
+
+		raw := d.Get("identity").([]interface{})
+		if len(raw) != 1 {
+			t.Fatalf("expected 1 identity but got %d", len(raw))
+		}
+		actual := raw[0].(map[string]interface{})
+		if v, ok := actual["identity_ids"].(*schema.Set); ok {
+			actual["identity_ids"] = v.List()
+		}
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
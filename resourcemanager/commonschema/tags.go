@@ -6,6 +6,7 @@ package commonschema
 import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func TagsDataSource() *schema.Schema {
@@ -62,3 +63,29 @@ func TagsWithLowerCaseKeys() *schema.Schema {
 		},
 	}
 }
+
+// TagsAll returns the schema for the `tags_all` field, which contains the Tags specified on the Resource
+// merged with the default Tags - and should be computed using tags.TagsAllCustomizeDiff
+func TagsAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// TagsWithCaseInsensitiveKeys returns the schema for Tags where differences in the casing of the keys are
+// suppressed, and keys which only differ by casing are rejected - matching how Azure treats Tag keys
+func TagsWithCaseInsensitiveKeys() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeMap,
+		Optional:         true,
+		ValidateFunc:     validation.All(tags.Validate, tags.ValidateUniqueKeysCaseInsensitive),
+		DiffSuppressFunc: tags.DiffSuppressFunc,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package edgezones
+
+import (
+	"embed"
+	"sort"
+
+	"github.com/hashicorp/go-azure-helpers/internal/catalogueloader"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+// EdgeZone describes an Azure Edge Zone and the Location it's attached to
+type EdgeZone struct {
+	// Name is the canonical name for this Edge Zone, e.g. `microsoftlosangeles1`
+	Name string `json:"name"`
+
+	// DisplayName is the human readable name for this Edge Zone, e.g. `Microsoft Los Angeles 1`
+	DisplayName string `json:"displayName"`
+
+	// ParentLocation is the canonical name of the Location this Edge Zone is attached to, e.g. `westus`
+	ParentLocation string `json:"parentLocation"`
+}
+
+// Catalogue is an offline list of the Edge Zones available within a Cloud - this can be empty when no
+// Edge Zones are known within the Cloud (for example US Government and China), in which case every Edge
+// Zone is unknown and validation is skipped
+type Catalogue struct {
+	// Cloud is the Cloud which this Catalogue describes
+	Cloud location.Cloud `json:"cloud"`
+
+	// Version is the date which this Catalogue was last updated, in the format `YYYY-MM-DD`
+	Version string `json:"version"`
+
+	// EdgeZones is the list of Edge Zones within this Cloud, sorted by Name
+	EdgeZones []EdgeZone `json:"edgeZones"`
+}
+
+// EdgeZone returns the Edge Zone within this Catalogue matching `input`
+func (c Catalogue) EdgeZone(input string) (*EdgeZone, bool) {
+	normalized := Normalize(input)
+	for _, v := range c.EdgeZones {
+		if v.Name == normalized {
+			edgeZone := v
+			return &edgeZone, true
+		}
+	}
+
+	return nil, false
+}
+
+// EdgeZonesForLocation returns the Edge Zones within this Catalogue which are attached to the Location `loc`
+func (c Catalogue) EdgeZonesForLocation(loc string) []EdgeZone {
+	normalized := location.NormalizeCanonical(loc)
+
+	out := make([]EdgeZone, 0)
+	for _, v := range c.EdgeZones {
+		if v.ParentLocation == normalized {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+//go:embed catalogue/*.json
+var catalogueFiles embed.FS
+
+var catalogueLoader = catalogueloader.New(catalogueFiles, "Edge Zone", location.PossibleValuesForCloud(), func(c Catalogue) location.Cloud {
+	return c.Cloud
+}, func(c *Catalogue) {
+	sort.Slice(c.EdgeZones, func(i, j int) bool {
+		return c.EdgeZones[i].Name < c.EdgeZones[j].Name
+	})
+})
+
+// CatalogueForCloud returns the embedded Edge Zone Catalogue for the specified Cloud
+func CatalogueForCloud(cloud location.Cloud) (*Catalogue, error) {
+	catalogue, err := catalogueLoader.CatalogueForCloud(cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy the Edge Zones so that the embedded Catalogue can't be modified by callers
+	catalogue.EdgeZones = append([]EdgeZone{}, catalogue.EdgeZones...)
+	return catalogue, nil
+}
+
+// LookupEdgeZone returns the Edge Zone matching `input` from the embedded Catalogue for the Cloud `cloud`
+func LookupEdgeZone(cloud location.Cloud, input string) (*EdgeZone, bool) {
+	catalogue, err := CatalogueForCloud(cloud)
+	if err != nil {
+		return nil, false
+	}
+
+	return catalogue.EdgeZone(input)
+}
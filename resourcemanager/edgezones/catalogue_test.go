@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package edgezones
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCatalogueIsConsistent(t *testing.T) {
+	for _, cloud := range location.PossibleValuesForCloud() {
+		catalogue, err := CatalogueForCloud(cloud)
+		if err != nil {
+			t.Fatalf("loading the catalogue for %q: %+v", cloud, err)
+		}
+
+		locations, err := location.CatalogueForCloud(cloud)
+		if err != nil {
+			t.Fatalf("loading the location catalogue for %q: %+v", cloud, err)
+		}
+
+		for _, edgeZone := range catalogue.EdgeZones {
+			if edgeZone.Name != Normalize(edgeZone.Name) {
+				t.Fatalf("expected the edge zone %q in %q to use the canonical name", edgeZone.Name, cloud)
+			}
+			if _, ok := locations.Region(edgeZone.ParentLocation); !ok {
+				t.Fatalf("the parent location %q for the edge zone %q was not found in %q", edgeZone.ParentLocation, edgeZone.Name, cloud)
+			}
+		}
+	}
+}
+
+func TestLookupEdgeZone(t *testing.T) {
+	edgeZone, ok := LookupEdgeZone(location.CloudPublic, "MicrosoftLosAngeles1")
+	if !ok {
+		t.Fatalf("expected `MicrosoftLosAngeles1` to be found")
+	}
+	if edgeZone.ParentLocation != "westus" {
+		t.Fatalf("expected `westus` but got %q", edgeZone.ParentLocation)
+	}
+
+	if _, ok := LookupEdgeZone(location.CloudPublic, "atlantis1"); ok {
+		t.Fatalf("expected `atlantis1` not to be found")
+	}
+
+	// the lookup is scoped to the specified Cloud
+	if _, ok := LookupEdgeZone(location.CloudChina, "microsoftlosangeles1"); ok {
+		t.Fatalf("expected `microsoftlosangeles1` not to be found in %q", location.CloudChina)
+	}
+}
+
+func TestValidatorEmbeddedCatalogue(t *testing.T) {
+	testData := []struct {
+		cloud    location.Cloud
+		edgeZone string
+		location string
+		valid    bool
+	}{
+		{
+			cloud:    location.CloudPublic,
+			edgeZone: "microsoftlosangeles1",
+			location: "westus",
+			valid:    true,
+		},
+		{
+			cloud:    location.CloudPublic,
+			edgeZone: "microsoftlosangeles1",
+			location: "eastus",
+			valid:    false,
+		},
+		{
+			// no Edge Zones are known within these Clouds, so these can't be validated
+			cloud:    location.CloudUSGovernment,
+			edgeZone: "examplegovedgezone1",
+			location: "usgovvirginia",
+			valid:    true,
+		},
+		{
+			cloud:    location.CloudChina,
+			edgeZone: "examplechinaedgezone1",
+			location: "chinanorth3",
+			valid:    true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q in %q (%s)", v.edgeZone, v.location, v.cloud)
+
+		err := NewValidator(v.cloud).Validate(v.edgeZone, v.location)
+		if v.valid != (err == nil) {
+			t.Fatalf("expected valid to be %t but got %+v", v.valid, err)
+		}
+	}
+}
+
+func fixtureCatalogue() Catalogue {
+	return Catalogue{
+		Cloud:   "fixture",
+		Version: "2025-01-01",
+		EdgeZones: []EdgeZone{
+			{
+				Name:           "fixtureedgezone1",
+				DisplayName:    "Fixture Edge Zone 1",
+				ParentLocation: "westus",
+			},
+		},
+	}
+}
+
+func TestValidatorValidate(t *testing.T) {
+	validator := NewValidatorFromCatalogue(fixtureCatalogue())
+
+	testData := []struct {
+		edgeZone string
+		location string
+		valid    bool
+	}{
+		{
+			edgeZone: "fixtureedgezone1",
+			location: "westus",
+			valid:    true,
+		},
+		{
+			edgeZone: "FixtureEdgeZone1",
+			location: "West US",
+			valid:    true,
+		},
+		{
+			edgeZone: "fixtureedgezone1",
+			location: "eastus",
+			valid:    false,
+		},
+		{
+			// unknown edge zones can't be validated
+			edgeZone: "unknownedgezone1",
+			location: "eastus",
+			valid:    true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q in %q", v.edgeZone, v.location)
+
+		err := validator.Validate(v.edgeZone, v.location)
+		if v.valid != (err == nil) {
+			t.Fatalf("expected valid to be %t but got %+v", v.valid, err)
+		}
+	}
+}
+
+func TestValidatorCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"edge_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		CustomizeDiff: NewValidatorFromCatalogue(fixtureCatalogue()).CustomizeDiff("edge_zone", "location"),
+	}
+
+	if _, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"location":  "westus",
+		"edge_zone": "fixtureedgezone1",
+	}), nil); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if _, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"location":  "eastus",
+		"edge_zone": "fixtureedgezone1",
+	}), nil); err == nil {
+		t.Fatalf("expected an error when the edge zone isn't attached to the location")
+	}
+}
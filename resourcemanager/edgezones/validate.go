@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package edgezones
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Validator validates that an Edge Zone is attached to a Location, using a Catalogue of Edge Zones.
+//
+// An error is returned when the Catalogue knows that the Edge Zone is attached to a different Location,
+// Edge Zones which aren't in the Catalogue are skipped - in line with the Zones Validator.
+type Validator struct {
+	lookup func(input string) (*EdgeZone, bool)
+}
+
+// NewValidator returns a Validator using the embedded Edge Zone Catalogue for the Cloud `cloud`
+func NewValidator(cloud location.Cloud) Validator {
+	return Validator{
+		lookup: func(input string) (*EdgeZone, bool) {
+			return LookupEdgeZone(cloud, input)
+		},
+	}
+}
+
+// NewValidatorFromCatalogue returns a Validator using the specified Edge Zone Catalogue
+func NewValidatorFromCatalogue(catalogue Catalogue) Validator {
+	return Validator{
+		lookup: catalogue.EdgeZone,
+	}
+}
+
+// Validate returns an error if the Edge Zone `edgeZone` isn't attached to the Location `loc`.
+//
+// NOTE: this is best-effort - when the Edge Zone isn't known no error is returned, since
+// new Edge Zones can become available before they're added to the Catalogue.
+func (v Validator) Validate(edgeZone, loc string) error {
+	if v.lookup == nil {
+		return nil
+	}
+
+	zone, ok := v.lookup(edgeZone)
+	if !ok {
+		return nil
+	}
+
+	if normalized := location.NormalizeCanonical(loc); zone.ParentLocation != normalized {
+		return fmt.Errorf("the Edge Zone %q is attached to the Location %q but the Location %q was specified", zone.Name, zone.ParentLocation, normalized)
+	}
+
+	return nil
+}
+
+// CustomizeDiff returns a CustomizeDiffFunc which validates that the Edge Zone in the field `edgeZoneKey`
+// is attached to the Location in the field `locationKey`. Validation is skipped when either value isn't yet known.
+func (v Validator) CustomizeDiff(edgeZoneKey, locationKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(edgeZoneKey) || !d.NewValueKnown(locationKey) {
+			return nil
+		}
+
+		edgeZone := d.Get(edgeZoneKey).(string)
+		loc := d.Get(locationKey).(string)
+		if edgeZone == "" || loc == "" {
+			return nil
+		}
+
+		if err := v.Validate(edgeZone, loc); err != nil {
+			return fmt.Errorf("%s: %+v", edgeZoneKey, err)
+		}
+
+		return nil
+	}
+}
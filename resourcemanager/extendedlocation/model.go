@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package extendedlocation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+type Type string
+
+const (
+	TypeArcZone        Type = "ArcZone"
+	TypeCustomLocation Type = "CustomLocation"
+	TypeEdgeZone       Type = "EdgeZone"
+)
+
+func PossibleValuesForType() []string {
+	return []string{
+		string(TypeArcZone),
+		string(TypeCustomLocation),
+		string(TypeEdgeZone),
+	}
+}
+
+func normalizeType(input Type) Type {
+	for _, v := range PossibleValuesForType() {
+		if strings.EqualFold(string(input), v) {
+			return Type(v)
+		}
+	}
+	return input
+}
+
+var (
+	_ json.Marshaler   = &Model{}
+	_ json.Unmarshaler = &Model{}
+)
+
+// Model represents an Extended Location, which is either an Edge Zone, an Azure Arc Custom Location
+// or an Azure Arc Zone.
+//
+// Unlike edgezones.Model, which only handles Edge Zones, the Type is retained for any Extended Location
+// returned from the API - including Types which aren't known about yet.
+type Model struct {
+	Name string `json:"name" tfschema:"name"`
+	Type Type   `json:"type" tfschema:"type"`
+}
+
+func (m *Model) MarshalJSON() ([]byte, error) {
+	out := map[string]interface{}{}
+
+	if m.Name != "" {
+		out["name"] = m.Name
+		out["type"] = string(normalizeType(m.Type))
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes the Extended Location leniently - an Extended Location without a Name or Type is
+// decoded as an empty Model, and unknown Types are retained as-is. UnmarshalStrict can be used to reject these.
+func (m *Model) UnmarshalJSON(bytes []byte) error {
+	decoded, err := unmarshal(bytes)
+	if err != nil {
+		return err
+	}
+	if decoded == nil {
+		return nil
+	}
+
+	*m = *decoded
+	return nil
+}
+
+// UnmarshalStrict decodes the Extended Location in `bytes`, returning an error if the Name or Type are
+// missing, the Type isn't known or the Name isn't valid for the Type
+func UnmarshalStrict(bytes []byte) (*Model, error) {
+	decoded, err := unmarshal(bytes)
+	if err != nil {
+		return nil, err
+	}
+	if decoded == nil {
+		return nil, fmt.Errorf("the Extended Location must specify both a `name` and a `type`")
+	}
+
+	if err := decoded.Validate(); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+func unmarshal(bytes []byte) (*Model, error) {
+	var decoded struct {
+		Name *string `json:"name"`
+		Type *string `json:"type"`
+	}
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return nil, fmt.Errorf("decoding: %+v", err)
+	}
+
+	if decoded.Name == nil || decoded.Type == nil {
+		return nil, nil
+	}
+
+	return &Model{
+		Name: *decoded.Name,
+		Type: normalizeType(Type(*decoded.Type)),
+	}, nil
+}
+
+// Validate returns an error if the Type of this Extended Location isn't known, or the Name isn't valid for the Type
+func (m Model) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("the Extended Location must specify a `name`")
+	}
+
+	switch normalizeType(m.Type) {
+	case TypeArcZone, TypeEdgeZone:
+		return nil
+
+	case TypeCustomLocation:
+		if _, err := m.CustomLocationID(); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return fmt.Errorf("the Extended Location type %q is not supported, expected one of %q", string(m.Type), PossibleValuesForType())
+}
+
+// CustomLocationID parses the Name of this Extended Location as a Custom Location ID, returning an error
+// if this Extended Location isn't a Custom Location or the ID isn't in the canonical casing
+func (m Model) CustomLocationID() (*commonids.CustomLocationId, error) {
+	if normalizeType(m.Type) != TypeCustomLocation {
+		return nil, fmt.Errorf("the Extended Location %q is of type %q rather than %q", m.Name, string(m.Type), string(TypeCustomLocation))
+	}
+
+	id, err := commonids.ParseCustomLocationID(m.Name)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Custom Location ID for the Extended Location: %+v", err)
+	}
+
+	return id, nil
+}
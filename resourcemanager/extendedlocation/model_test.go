@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package extendedlocation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const customLocationId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.ExtendedLocation/customLocations/example"
+
+func TestMarshalModel(t *testing.T) {
+	testData := []struct {
+		Input    Model
+		Expected string
+	}{
+		{
+			Input:    Model{},
+			Expected: `{}`,
+		},
+		{
+			Input: Model{
+				Name: "microsoftlosangeles1",
+				Type: TypeEdgeZone,
+			},
+			Expected: `{"name":"microsoftlosangeles1","type":"EdgeZone"}`,
+		},
+		{
+			Input: Model{
+				Name: customLocationId,
+				Type: "customlocation",
+			},
+			Expected: `{"name":"` + customLocationId + `","type":"CustomLocation"}`,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q (%q)", v.Input.Name, string(v.Input.Type))
+
+		out, err := json.Marshal(&v.Input)
+		if err != nil {
+			t.Fatalf("marshaling: %+v", err)
+		}
+
+		if string(out) != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, string(out))
+		}
+	}
+}
+
+func TestUnmarshalModel(t *testing.T) {
+	testData := []struct {
+		Payload        string
+		Expected       Model
+		ExpectedStrict bool
+	}{
+		{
+			Payload:        `{}`,
+			Expected:       Model{},
+			ExpectedStrict: false,
+		},
+		{
+			Payload:        `{"name": "microsoftlosangeles1"}`,
+			Expected:       Model{},
+			ExpectedStrict: false,
+		},
+		{
+			Payload: `{"name": "microsoftlosangeles1", "type": "edgezone"}`,
+			Expected: Model{
+				Name: "microsoftlosangeles1",
+				Type: TypeEdgeZone,
+			},
+			ExpectedStrict: true,
+		},
+		{
+			Payload: `{"name": "` + customLocationId + `", "type": "CustomLocation"}`,
+			Expected: Model{
+				Name: customLocationId,
+				Type: TypeCustomLocation,
+			},
+			ExpectedStrict: true,
+		},
+		{
+			// not a Custom Location ID
+			Payload: `{"name": "example", "type": "CustomLocation"}`,
+			Expected: Model{
+				Name: "example",
+				Type: TypeCustomLocation,
+			},
+			ExpectedStrict: false,
+		},
+		{
+			Payload: `{"name": "example", "type": "ArcZone"}`,
+			Expected: Model{
+				Name: "example",
+				Type: TypeArcZone,
+			},
+			ExpectedStrict: true,
+		},
+		{
+			// unknown types are retained when unmarshaling leniently
+			Payload: `{"name": "example", "type": "Borg"}`,
+			Expected: Model{
+				Name: "example",
+				Type: "Borg",
+			},
+			ExpectedStrict: false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s", v.Payload)
+
+		var actual Model
+		if err := json.Unmarshal([]byte(v.Payload), &actual); err != nil {
+			t.Fatalf("unmarshaling: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+
+		strict, err := UnmarshalStrict([]byte(v.Payload))
+		if v.ExpectedStrict {
+			if err != nil {
+				t.Fatalf("unmarshaling strictly: %+v", err)
+			}
+			if *strict != v.Expected {
+				t.Fatalf("expected %+v but got %+v when unmarshaling strictly", v.Expected, *strict)
+			}
+		} else if err == nil {
+			t.Fatalf("expected an error when unmarshaling strictly but didn't get one")
+		}
+	}
+}
+
+func TestModelCustomLocationID(t *testing.T) {
+	id, err := Model{Name: customLocationId, Type: TypeCustomLocation}.CustomLocationID()
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+	if id.CustomLocationName != "example" {
+		t.Fatalf("expected the Custom Location Name to be `example` but got %q", id.CustomLocationName)
+	}
+
+	if _, err := (Model{Name: "microsoftlosangeles1", Type: TypeEdgeZone}).CustomLocationID(); err == nil {
+		t.Fatalf("expected an error for an Edge Zone but didn't get one")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package extendedlocation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ExpandExtendedLocation expands the schema input into an Extended Location Model, returning nil when
+// no Extended Location has been specified
+func ExpandExtendedLocation(input []interface{}) (*Model, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw, ok := input[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the Extended Location to be a map but got %T", input[0])
+	}
+
+	out := Model{
+		Name: raw["name"].(string),
+		Type: normalizeType(Type(raw["type"].(string))),
+	}
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// FlattenExtendedLocation flattens the Extended Location Model into the schema representation, returning
+// an empty list when no Extended Location is present
+func FlattenExtendedLocation(input *Model) []interface{} {
+	if input == nil || input.Name == "" {
+		return []interface{}{}
+	}
+
+	name := input.Name
+	switch normalizeType(input.Type) {
+	case TypeEdgeZone:
+		name = edgezones.Normalize(name)
+
+	case TypeCustomLocation:
+		// the API doesn't always return the Custom Location ID in the canonical casing
+		if id, err := commonids.ParseCustomLocationIDInsensitively(name); err == nil {
+			name = id.ID()
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name": name,
+			"type": string(normalizeType(input.Type)),
+		},
+	}
+}
+
+// NameDiffSuppressFunc suppresses differences in the casing of the `name` field, which the API doesn't always
+// return in the format that was specified. Differences in spacing are also suppressed for Edge Zones.
+func NameDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d != nil {
+		typeKey := strings.TrimSuffix(k, "name") + "type"
+		if v, ok := d.Get(typeKey).(string); ok && normalizeType(Type(v)) == TypeEdgeZone {
+			return edgezones.Normalize(old) == edgezones.Normalize(new)
+		}
+	}
+
+	return strings.EqualFold(old, new)
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package extendedlocation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExpandExtendedLocation(t *testing.T) {
+	testData := []struct {
+		Input    []interface{}
+		Expected *Model
+		Error    bool
+	}{
+		{
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"name": "microsoftlosangeles1",
+					"type": "EdgeZone",
+				},
+			},
+			Expected: &Model{
+				Name: "microsoftlosangeles1",
+				Type: TypeEdgeZone,
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"name": customLocationId,
+					"type": "CustomLocation",
+				},
+			},
+			Expected: &Model{
+				Name: customLocationId,
+				Type: TypeCustomLocation,
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"name": "example",
+					"type": "CustomLocation",
+				},
+			},
+			Error: true,
+		},
+		{
+			// the Custom Location ID must be in the canonical casing
+			Input: []interface{}{
+				map[string]interface{}{
+					"name": strings.ToLower(customLocationId),
+					"type": "CustomLocation",
+				},
+			},
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.Input)
+
+		actual, err := ExpandExtendedLocation(v.Input)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expanding: %+v", err)
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenExtendedLocation(t *testing.T) {
+	testData := []struct {
+		Input    *Model
+		Expected []interface{}
+	}{
+		{
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Input: &Model{
+				Name: "Microsoft Los Angeles 1",
+				Type: "edgeZone",
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"name": "microsoftlosangeles1",
+					"type": "EdgeZone",
+				},
+			},
+		},
+		{
+			Input: &Model{
+				Name: strings.ReplaceAll(customLocationId, "resourceGroups", "resourcegroups"),
+				Type: TypeCustomLocation,
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"name": customLocationId,
+					"type": "CustomLocation",
+				},
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.Input)
+
+		actual := FlattenExtendedLocation(v.Input)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Capabilities describes the Identity configurations supported by a Resource, which can be used to
+// validate the `identity` block at plan time rather than when it's expanded.
+type Capabilities struct {
+	// SupportedTypes is the list of Types supported by this Resource, when empty all Types are supported.
+	SupportedTypes []Type
+
+	// MaxUserAssignedIdentities is the maximum number of User Assigned Identities which can be assigned
+	// to this Resource, when zero this is unlimited.
+	MaxUserAssignedIdentities int
+}
+
+// CapabilityError is a validation error for a specific field within the `identity` block
+type CapabilityError struct {
+	// Field is the name of the field within the `identity` block, e.g. `type` or `identity_ids`
+	Field string
+
+	// Summary is a short description of the validation error
+	Summary string
+
+	// Detail is a longer description of the validation error
+	Detail string
+}
+
+func (e CapabilityError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Detail)
+}
+
+// Validate checks that the Identity Type `identityType` with the User Assigned Identities `identityIds` is
+// supported by these Capabilities, returning a CapabilityError for each problem found
+func (c Capabilities) Validate(identityType Type, identityIds []string) []CapabilityError {
+	output := make([]CapabilityError, 0)
+
+	if len(c.SupportedTypes) > 0 {
+		supported := false
+		for _, v := range c.SupportedTypes {
+			if v == identityType {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			types := make([]string, 0)
+			for _, v := range c.SupportedTypes {
+				types = append(types, fmt.Sprintf("%q", string(v)))
+			}
+			output = append(output, CapabilityError{
+				Field:   "type",
+				Summary: "Unsupported Identity Type",
+				Detail:  fmt.Sprintf("the Identity Type %q is not supported, expected one of %s", string(identityType), strings.Join(types, ", ")),
+			})
+		}
+	}
+
+	userAssigned := identityType == TypeUserAssigned || identityType == TypeSystemAssignedUserAssigned
+	if userAssigned && len(identityIds) == 0 {
+		output = append(output, CapabilityError{
+			Field:   "identity_ids",
+			Summary: "Missing User Assigned Identities",
+			Detail:  fmt.Sprintf("`identity_ids` must be specified when `type` is set to %q", string(identityType)),
+		})
+	}
+	if !userAssigned && len(identityIds) > 0 {
+		output = append(output, CapabilityError{
+			Field:   "identity_ids",
+			Summary: "Unexpected User Assigned Identities",
+			Detail:  fmt.Sprintf("`identity_ids` can only be specified when `type` is set to %q or %q", string(TypeSystemAssignedUserAssigned), string(TypeUserAssigned)),
+		})
+	}
+	if userAssigned && c.MaxUserAssignedIdentities > 0 && len(identityIds) > c.MaxUserAssignedIdentities {
+		output = append(output, CapabilityError{
+			Field:   "identity_ids",
+			Summary: "Too many User Assigned Identities",
+			Detail:  fmt.Sprintf("at most %d User Assigned Identities can be specified but got %d", c.MaxUserAssignedIdentities, len(identityIds)),
+		})
+	}
+
+	return output
+}
+
+// CustomizeDiff returns a CustomizeDiffFunc which validates the SDKv2 `identity` block with the name `key`
+// against these Capabilities. Validation is skipped when the `type` or `identity_ids` aren't yet known.
+func (c Capabilities) CustomizeDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		typeKey := fmt.Sprintf("%s.0.type", key)
+		identityIdsKey := fmt.Sprintf("%s.0.identity_ids", key)
+
+		raw, ok := d.Get(key).([]interface{})
+		if !ok || len(raw) == 0 || raw[0] == nil {
+			return nil
+		}
+		if !d.NewValueKnown(typeKey) || !d.NewValueKnown(identityIdsKey) {
+			return nil
+		}
+
+		block := raw[0].(map[string]interface{})
+		identityType := Type(block["type"].(string))
+		identityIds := make([]string, 0)
+		switch v := block["identity_ids"].(type) {
+		case *schema.Set:
+			for _, id := range v.List() {
+				identityIds = append(identityIds, id.(string))
+			}
+		case []interface{}:
+			for _, id := range v {
+				identityIds = append(identityIds, id.(string))
+			}
+		}
+
+		errs := make([]error, 0)
+		for _, v := range c.Validate(identityType, identityIds) {
+			errs = append(errs, fmt.Errorf("%s.0.%s: %s", key, v.Field, v.Detail))
+		}
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCapabilitiesValidate(t *testing.T) {
+	identityId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+	testData := []struct {
+		name         string
+		capabilities Capabilities
+		identityType Type
+		identityIds  []string
+		expected     []string
+	}{
+		{
+			name:         "system assigned",
+			identityType: TypeSystemAssigned,
+		},
+		{
+			name:         "system assigned with identity ids",
+			identityType: TypeSystemAssigned,
+			identityIds:  []string{identityId},
+			expected:     []string{"identity_ids"},
+		},
+		{
+			name:         "user assigned",
+			identityType: TypeUserAssigned,
+			identityIds:  []string{identityId},
+		},
+		{
+			name:         "user assigned without identity ids",
+			identityType: TypeUserAssigned,
+			expected:     []string{"identity_ids"},
+		},
+		{
+			name:         "system and user assigned without identity ids",
+			identityType: TypeSystemAssignedUserAssigned,
+			expected:     []string{"identity_ids"},
+		},
+		{
+			name: "unsupported type",
+			capabilities: Capabilities{
+				SupportedTypes: []Type{TypeSystemAssigned},
+			},
+			identityType: TypeUserAssigned,
+			identityIds:  []string{identityId},
+			expected:     []string{"type"},
+		},
+		{
+			name: "too many identities",
+			capabilities: Capabilities{
+				MaxUserAssignedIdentities: 1,
+			},
+			identityType: TypeUserAssigned,
+			identityIds:  []string{identityId, identityId + "2"},
+			expected:     []string{"identity_ids"},
+		},
+		{
+			name: "unsupported type without identity ids",
+			capabilities: Capabilities{
+				SupportedTypes: []Type{TypeSystemAssigned},
+			},
+			identityType: TypeUserAssigned,
+			expected:     []string{"type", "identity_ids"},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := v.capabilities.Validate(v.identityType, v.identityIds)
+		fields := make([]string, 0)
+		for _, err := range actual {
+			fields = append(fields, err.Field)
+		}
+		if strings.Join(fields, ",") != strings.Join(v.expected, ",") {
+			t.Fatalf("expected errors for %v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestCapabilitiesCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"identity_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: Capabilities{
+			MaxUserAssignedIdentities: 1,
+		}.CustomizeDiff("identity"),
+	}
+
+	testData := []struct {
+		name     string
+		config   map[string]interface{}
+		expected string
+	}{
+		{
+			name:   "no identity",
+			config: map[string]interface{}{},
+		},
+		{
+			name: "system assigned",
+			config: map[string]interface{}{
+				"identity": []interface{}{
+					map[string]interface{}{
+						"type": "SystemAssigned",
+					},
+				},
+			},
+		},
+		{
+			name: "user assigned without identity ids",
+			config: map[string]interface{}{
+				"identity": []interface{}{
+					map[string]interface{}{
+						"type": "UserAssigned",
+					},
+				},
+			},
+			expected: "identity.0.identity_ids",
+		},
+		{
+			name: "too many identity ids",
+			config: map[string]interface{}{
+				"identity": []interface{}{
+					map[string]interface{}{
+						"type":         "UserAssigned",
+						"identity_ids": []interface{}{"first", "second"},
+					},
+				},
+			},
+			expected: "identity.0.identity_ids",
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(v.config), nil)
+		if v.expected == "" {
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", v.expected)
+		}
+		if !strings.Contains(err.Error(), v.expected) {
+			t.Fatalf("expected the error to reference %q but got %+v", v.expected, err)
+		}
+	}
+}
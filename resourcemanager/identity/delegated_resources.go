@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DelegatedResource is a Resource which has been delegated access to a Managed Identity, the API
+// returns these as a map of String : DelegatedResource within the `delegatedResources` field.
+type DelegatedResource struct {
+	Location         string `json:"location,omitempty"`
+	ReferralResource string `json:"referralResource,omitempty"`
+	ResourceId       string `json:"resourceId,omitempty"`
+	TenantId         string `json:"tenantId,omitempty"`
+}
+
+// ExpandDelegatedResources expands the schema input into a map of String : DelegatedResource
+func ExpandDelegatedResources(input []interface{}) (map[string]DelegatedResource, error) {
+	output := make(map[string]DelegatedResource)
+
+	for _, item := range input {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := raw["name"].(string)
+		if _, exists := output[name]; exists {
+			return nil, fmt.Errorf("the Delegated Resource %q was specified more than once", name)
+		}
+
+		output[name] = DelegatedResource{
+			Location:         raw["location"].(string),
+			ReferralResource: raw["referral_resource"].(string),
+			ResourceId:       raw["resource_id"].(string),
+			TenantId:         raw["tenant_id"].(string),
+		}
+	}
+
+	return output, nil
+}
+
+// FlattenDelegatedResources turns a map of String : DelegatedResource into a []interface{}, sorted by name
+func FlattenDelegatedResources(input map[string]DelegatedResource) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, name := range sortedDelegatedResourceNames(input) {
+		v := input[name]
+		output = append(output, map[string]interface{}{
+			"name":              name,
+			"location":          v.Location,
+			"referral_resource": v.ReferralResource,
+			"resource_id":       v.ResourceId,
+			"tenant_id":         v.TenantId,
+		})
+	}
+
+	return output
+}
+
+// ExpandDelegatedResourcesFromModel expands the typed schema input into a map of String : DelegatedResource
+func ExpandDelegatedResourcesFromModel(input []ModelDelegatedResource) (map[string]DelegatedResource, error) {
+	output := make(map[string]DelegatedResource)
+
+	for _, v := range input {
+		if _, exists := output[v.Name]; exists {
+			return nil, fmt.Errorf("the Delegated Resource %q was specified more than once", v.Name)
+		}
+
+		output[v.Name] = DelegatedResource{
+			Location:         v.Location,
+			ReferralResource: v.ReferralResource,
+			ResourceId:       v.ResourceId,
+			TenantId:         v.TenantId,
+		}
+	}
+
+	return output, nil
+}
+
+// FlattenDelegatedResourcesToModel turns a map of String : DelegatedResource into a typed schema model, sorted by name
+func FlattenDelegatedResourcesToModel(input map[string]DelegatedResource) []ModelDelegatedResource {
+	output := make([]ModelDelegatedResource, 0)
+
+	for _, name := range sortedDelegatedResourceNames(input) {
+		v := input[name]
+		output = append(output, ModelDelegatedResource{
+			Name:             name,
+			Location:         v.Location,
+			ReferralResource: v.ReferralResource,
+			ResourceId:       v.ResourceId,
+			TenantId:         v.TenantId,
+		})
+	}
+
+	return output
+}
+
+func sortedDelegatedResourceNames(input map[string]DelegatedResource) []string {
+	names := make([]string, 0, len(input))
+	for k := range input {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
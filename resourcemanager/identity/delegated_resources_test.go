@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDelegatedResourcesJSON(t *testing.T) {
+	input := `{
+	  "first": {
+	    "resourceId": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster1",
+	    "tenantId": "11111111-1111-1111-1111-111111111111",
+	    "referralResource": "22222222-2222-2222-2222-222222222222",
+	    "location": "westeurope"
+	  },
+	  "second": {
+	    "resourceId": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster2"
+	  }
+	}`
+
+	var decoded map[string]DelegatedResource
+	if err := json.Unmarshal([]byte(input), &decoded); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+
+	expected := map[string]DelegatedResource{
+		"first": {
+			ResourceId:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster1",
+			TenantId:         "11111111-1111-1111-1111-111111111111",
+			ReferralResource: "22222222-2222-2222-2222-222222222222",
+			Location:         "westeurope",
+		},
+		"second": {
+			ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster2",
+		},
+	}
+	if !reflect.DeepEqual(expected, decoded) {
+		t.Fatalf("expected %+v but got %+v", expected, decoded)
+	}
+
+	encoded, err := json.Marshal(decoded["second"])
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	expectedJSON := `{"resourceId":"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster2"}`
+	if string(encoded) != expectedJSON {
+		t.Fatalf("expected %s but got %s", expectedJSON, string(encoded))
+	}
+}
+
+func TestExpandFlattenDelegatedResources(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":              "second",
+			"resource_id":       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster2",
+			"tenant_id":         "",
+			"referral_resource": "",
+			"location":          "",
+		},
+		map[string]interface{}{
+			"name":              "first",
+			"resource_id":       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster1",
+			"tenant_id":         "11111111-1111-1111-1111-111111111111",
+			"referral_resource": "22222222-2222-2222-2222-222222222222",
+			"location":          "westeurope",
+		},
+	}
+
+	expanded, err := ExpandDelegatedResources(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(expanded) != 2 {
+		t.Fatalf("expected 2 delegated resources but got %d", len(expanded))
+	}
+
+	// flattening is sorted by name
+	flattened := FlattenDelegatedResources(expanded)
+	expected := []interface{}{input[1], input[0]}
+	if !reflect.DeepEqual(expected, flattened) {
+		t.Fatalf("expected %+v but got %+v", expected, flattened)
+	}
+
+	if _, err := ExpandDelegatedResources(append(input, input[0])); err == nil {
+		t.Fatalf("expected an error for a duplicate name but didn't get one")
+	}
+}
+
+func TestExpandFlattenDelegatedResourcesModel(t *testing.T) {
+	input := []ModelDelegatedResource{
+		{
+			Name:       "first",
+			ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceFabric/managedClusters/cluster1",
+			TenantId:   "11111111-1111-1111-1111-111111111111",
+		},
+	}
+
+	expanded, err := ExpandDelegatedResourcesFromModel(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	flattened := FlattenDelegatedResourcesToModel(expanded)
+	if !reflect.DeepEqual(input, flattened) {
+		t.Fatalf("expected %+v but got %+v", input, flattened)
+	}
+
+	if _, err := ExpandDelegatedResourcesFromModel(append(input, input[0])); err == nil {
+		t.Fatalf("expected an error for a duplicate name but didn't get one")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ json.Marshaler = FederatedIdentityCredential{}
+
+// FederatedIdentityCredential is a reference to a Federated Identity Credential, which allows an
+// external Identity Provider (the Issuer) to exchange a token for the Subject for one for this Managed Identity.
+type FederatedIdentityCredential struct {
+	Name      string   `json:"name"`
+	Issuer    string   `json:"issuer"`
+	Subject   string   `json:"subject"`
+	Audiences []string `json:"audiences"`
+}
+
+func (f FederatedIdentityCredential) MarshalJSON() ([]byte, error) {
+	// the API requires that `audiences` is sent, even when empty
+	audiences := f.Audiences
+	if audiences == nil {
+		audiences = []string{}
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"name":      f.Name,
+		"issuer":    f.Issuer,
+		"subject":   f.Subject,
+		"audiences": audiences,
+	})
+}
+
+// ExpandFederatedIdentityCredentials expands the schema input into a slice of FederatedIdentityCredentials
+func ExpandFederatedIdentityCredentials(input []interface{}) (*[]FederatedIdentityCredential, error) {
+	output := make([]FederatedIdentityCredential, 0)
+	names := make(map[string]struct{})
+
+	for _, item := range input {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := raw["name"].(string)
+		if _, exists := names[name]; exists {
+			return nil, fmt.Errorf("the Federated Identity Credential %q was specified more than once", name)
+		}
+		names[name] = struct{}{}
+
+		audiences := make([]string, 0)
+		for _, v := range raw["audiences"].([]interface{}) {
+			audiences = append(audiences, v.(string))
+		}
+
+		output = append(output, FederatedIdentityCredential{
+			Name:      name,
+			Issuer:    raw["issuer"].(string),
+			Subject:   raw["subject"].(string),
+			Audiences: audiences,
+		})
+	}
+
+	return &output, nil
+}
+
+// FlattenFederatedIdentityCredentials turns a slice of FederatedIdentityCredentials into a []interface{}
+func FlattenFederatedIdentityCredentials(input *[]FederatedIdentityCredential) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		audiences := make([]interface{}, 0)
+		for _, audience := range v.Audiences {
+			audiences = append(audiences, audience)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":      v.Name,
+			"issuer":    v.Issuer,
+			"subject":   v.Subject,
+			"audiences": audiences,
+		})
+	}
+
+	return output
+}
+
+// ExpandFederatedIdentityCredentialsFromModel expands the typed schema input into a slice of FederatedIdentityCredentials
+func ExpandFederatedIdentityCredentialsFromModel(input []ModelFederatedIdentityCredential) (*[]FederatedIdentityCredential, error) {
+	output := make([]FederatedIdentityCredential, 0)
+	names := make(map[string]struct{})
+
+	for _, v := range input {
+		if _, exists := names[v.Name]; exists {
+			return nil, fmt.Errorf("the Federated Identity Credential %q was specified more than once", v.Name)
+		}
+		names[v.Name] = struct{}{}
+
+		output = append(output, FederatedIdentityCredential{
+			Name:      v.Name,
+			Issuer:    v.Issuer,
+			Subject:   v.Subject,
+			Audiences: v.Audiences,
+		})
+	}
+
+	return &output, nil
+}
+
+// FlattenFederatedIdentityCredentialsToModel turns a slice of FederatedIdentityCredentials into a typed schema model
+func FlattenFederatedIdentityCredentialsToModel(input *[]FederatedIdentityCredential) []ModelFederatedIdentityCredential {
+	output := make([]ModelFederatedIdentityCredential, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		audiences := make([]string, 0)
+		audiences = append(audiences, v.Audiences...)
+
+		output = append(output, ModelFederatedIdentityCredential{
+			Name:      v.Name,
+			Issuer:    v.Issuer,
+			Subject:   v.Subject,
+			Audiences: audiences,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFederatedIdentityCredentialMarshal(t *testing.T) {
+	testData := []struct {
+		input    FederatedIdentityCredential
+		expected string
+	}{
+		{
+			input:    FederatedIdentityCredential{},
+			expected: `{"audiences":[],"issuer":"","name":"","subject":""}`,
+		},
+		{
+			input: FederatedIdentityCredential{
+				Name:      "github",
+				Issuer:    "https://token.actions.githubusercontent.com",
+				Subject:   "repo:hashicorp/go-azure-helpers:ref:refs/heads/main",
+				Audiences: []string{"api://AzureADTokenExchange"},
+			},
+			expected: `{"audiences":["api://AzureADTokenExchange"],"issuer":"https://token.actions.githubusercontent.com","name":"github","subject":"repo:hashicorp/go-azure-helpers:ref:refs/heads/main"}`,
+		},
+	}
+	for _, v := range testData {
+		encoded, err := json.Marshal(v.input)
+		if err != nil {
+			t.Fatalf("marshaling: %+v", err)
+		}
+		if string(encoded) != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, string(encoded))
+		}
+
+		var decoded FederatedIdentityCredential
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("unmarshaling: %+v", err)
+		}
+		if decoded.Name != v.input.Name || decoded.Issuer != v.input.Issuer || decoded.Subject != v.input.Subject || len(decoded.Audiences) != len(v.input.Audiences) {
+			t.Fatalf("expected %+v but got %+v", v.input, decoded)
+		}
+	}
+}
+
+func TestExpandFlattenFederatedIdentityCredentials(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":      "github",
+			"issuer":    "https://token.actions.githubusercontent.com",
+			"subject":   "repo:hashicorp/go-azure-helpers:ref:refs/heads/main",
+			"audiences": []interface{}{"api://AzureADTokenExchange"},
+		},
+	}
+
+	expanded, err := ExpandFederatedIdentityCredentials(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected := []FederatedIdentityCredential{
+		{
+			Name:      "github",
+			Issuer:    "https://token.actions.githubusercontent.com",
+			Subject:   "repo:hashicorp/go-azure-helpers:ref:refs/heads/main",
+			Audiences: []string{"api://AzureADTokenExchange"},
+		},
+	}
+	if !reflect.DeepEqual(expected, *expanded) {
+		t.Fatalf("expected %+v but got %+v", expected, *expanded)
+	}
+
+	flattened := FlattenFederatedIdentityCredentials(expanded)
+	if !reflect.DeepEqual(input, flattened) {
+		t.Fatalf("expected %+v but got %+v", input, flattened)
+	}
+
+	if _, err := ExpandFederatedIdentityCredentials(append(input, input[0])); err == nil {
+		t.Fatalf("expected an error for a duplicate name but didn't get one")
+	}
+
+	model := FlattenFederatedIdentityCredentialsToModel(expanded)
+	expandedFromModel, err := ExpandFederatedIdentityCredentialsFromModel(model)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, *expandedFromModel) {
+		t.Fatalf("expected %+v but got %+v", expected, *expandedFromModel)
+	}
+
+	if len(FlattenFederatedIdentityCredentials(nil)) != 0 {
+		t.Fatalf("expected no items when flattening nil")
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Identity is a canonical representation of a Managed Identity, which can be converted to and from
+// each of the shapes used by the Azure APIs (e.g. SystemAndUserAssignedMap or UserAssignedList).
+//
+// Converting a shape into an Identity and back again is lossless - however since not every shape can
+// represent every Type, Validate can be used to check whether an Identity can be converted into a given Shape.
+type Identity struct {
+	Type        Type
+	PrincipalId string
+	TenantId    string
+
+	// UserAssignedIdentities is the ordered list of User Assigned Identities, ordering is retained when
+	// converting from a List shape and sorted by Resource ID when converting from a Map shape.
+	UserAssignedIdentities []UserAssignedIdentity
+}
+
+// UserAssignedIdentity is a User Assigned Identity which is assigned to an Identity
+type UserAssignedIdentity struct {
+	ResourceId  string
+	ClientId    *string
+	PrincipalId *string
+}
+
+// Shape describes one of the shapes of Managed Identity used by the Azure APIs
+type Shape string
+
+const (
+	ShapeSystemAssigned                  Shape = "SystemAssigned"
+	ShapeUserAssignedList                Shape = "UserAssignedList"
+	ShapeUserAssignedMap                 Shape = "UserAssignedMap"
+	ShapeSystemOrUserAssignedList        Shape = "SystemOrUserAssignedList"
+	ShapeSystemOrUserAssignedMap         Shape = "SystemOrUserAssignedMap"
+	ShapeSystemAndUserAssignedList       Shape = "SystemAndUserAssignedList"
+	ShapeSystemAndUserAssignedMap        Shape = "SystemAndUserAssignedMap"
+	ShapeLegacySystemAndUserAssignedList Shape = "LegacySystemAndUserAssignedList"
+	ShapeLegacySystemAndUserAssignedMap  Shape = "LegacySystemAndUserAssignedMap"
+)
+
+// SupportedTypes returns the Types which can be represented by this Shape
+func (s Shape) SupportedTypes() []Type {
+	switch s {
+	case ShapeSystemAssigned:
+		return []Type{TypeNone, TypeSystemAssigned}
+
+	case ShapeUserAssignedList, ShapeUserAssignedMap:
+		return []Type{TypeNone, TypeUserAssigned}
+
+	case ShapeSystemOrUserAssignedList, ShapeSystemOrUserAssignedMap:
+		return []Type{TypeNone, TypeSystemAssigned, TypeUserAssigned}
+
+	case ShapeSystemAndUserAssignedList, ShapeSystemAndUserAssignedMap, ShapeLegacySystemAndUserAssignedList, ShapeLegacySystemAndUserAssignedMap:
+		return []Type{TypeNone, TypeSystemAssigned, TypeUserAssigned, TypeSystemAssignedUserAssigned}
+	}
+
+	return []Type{}
+}
+
+// UnsupportedTypes returns the Types which cannot be represented by this Shape
+func (s Shape) UnsupportedTypes() []Type {
+	out := make([]Type, 0)
+	for _, t := range []Type{TypeNone, TypeSystemAssigned, TypeUserAssigned, TypeSystemAssignedUserAssigned} {
+		if !s.supportsType(t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func (s Shape) isMap() bool {
+	switch s {
+	case ShapeUserAssignedMap, ShapeSystemOrUserAssignedMap, ShapeSystemAndUserAssignedMap, ShapeLegacySystemAndUserAssignedMap:
+		return true
+	}
+	return false
+}
+
+func (s Shape) supportsType(input Type) bool {
+	for _, v := range s.SupportedTypes() {
+		if v == input {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if this Identity cannot be represented by the Shape `shape`, for example
+// when the Type is `SystemAssigned, UserAssigned` and the Shape only supports `UserAssigned`.
+func (i Identity) Validate(shape Shape) error {
+	identityType := normalizeType(i.Type)
+	if identityType == "" {
+		identityType = TypeNone
+	}
+
+	if !shape.supportsType(identityType) {
+		supported := make([]string, 0)
+		for _, v := range shape.SupportedTypes() {
+			supported = append(supported, fmt.Sprintf("%q", string(v)))
+		}
+		unsupported := make([]string, 0)
+		for _, v := range shape.UnsupportedTypes() {
+			unsupported = append(unsupported, fmt.Sprintf("%q", string(v)))
+		}
+		return fmt.Errorf("the identity type %q cannot be represented by the shape %q, which supports %s but not %s", string(i.Type), string(shape), strings.Join(supported, ", "), strings.Join(unsupported, ", "))
+	}
+
+	// the SystemAssigned shape has no field for User Assigned Identities, so these would otherwise be dropped
+	if shape == ShapeSystemAssigned && len(i.UserAssignedIdentities) > 0 {
+		return fmt.Errorf("the User Assigned Identities %q cannot be represented by the shape %q", i.IdentityIds(), string(shape))
+	}
+
+	if shape.isMap() {
+		// Resource IDs are the keys for Map shapes and are compared case-insensitively by the API, so each must be unique
+		seen := make(map[string]struct{}, len(i.UserAssignedIdentities))
+		for _, v := range i.UserAssignedIdentities {
+			key := strings.ToLower(v.ResourceId)
+			if _, ok := seen[key]; ok {
+				return fmt.Errorf("the User Assigned Identity %q was specified more than once, which cannot be represented by the shape %q", v.ResourceId, string(shape))
+			}
+			seen[key] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// IdentityIds returns the Resource IDs of the User Assigned Identities assigned to this Identity
+func (i Identity) IdentityIds() []string {
+	out := make([]string, 0, len(i.UserAssignedIdentities))
+	for _, v := range i.UserAssignedIdentities {
+		out = append(out, v.ResourceId)
+	}
+	return out
+}
+
+func (i Identity) identityIdsList() []string {
+	if i.UserAssignedIdentities == nil {
+		return nil
+	}
+	return i.IdentityIds()
+}
+
+func (i Identity) identityIdsMap() map[string]UserAssignedIdentityDetails {
+	if i.UserAssignedIdentities == nil {
+		return nil
+	}
+
+	out := make(map[string]UserAssignedIdentityDetails, len(i.UserAssignedIdentities))
+	for _, v := range i.UserAssignedIdentities {
+		out[v.ResourceId] = UserAssignedIdentityDetails{
+			ClientId:    v.ClientId,
+			PrincipalId: v.PrincipalId,
+		}
+	}
+	return out
+}
+
+func userAssignedIdentitiesFromList(input []string) []UserAssignedIdentity {
+	if input == nil {
+		return nil
+	}
+
+	out := make([]UserAssignedIdentity, 0, len(input))
+	for _, v := range input {
+		out = append(out, UserAssignedIdentity{
+			ResourceId: v,
+		})
+	}
+	return out
+}
+
+func userAssignedIdentitiesFromMap(input map[string]UserAssignedIdentityDetails) []UserAssignedIdentity {
+	if input == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]UserAssignedIdentity, 0, len(input))
+	for _, k := range keys {
+		out = append(out, UserAssignedIdentity{
+			ResourceId:  k,
+			ClientId:    input[k].ClientId,
+			PrincipalId: input[k].PrincipalId,
+		})
+	}
+	return out
+}
+
+// FromSystemAssigned converts a SystemAssigned into an Identity
+func FromSystemAssigned(input *SystemAssigned) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:        input.Type,
+		PrincipalId: input.PrincipalId,
+		TenantId:    input.TenantId,
+	}
+}
+
+// ToSystemAssigned converts this Identity into a SystemAssigned, returning an error if any User Assigned
+// Identities are specified since these cannot be represented
+func (i Identity) ToSystemAssigned() (*SystemAssigned, error) {
+	if err := i.Validate(ShapeSystemAssigned); err != nil {
+		return nil, err
+	}
+
+	return &SystemAssigned{
+		Type:        normalizeType(i.Type),
+		PrincipalId: i.PrincipalId,
+		TenantId:    i.TenantId,
+	}, nil
+}
+
+// FromUserAssignedList converts a UserAssignedList into an Identity
+func FromUserAssignedList(input *UserAssignedList) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:                   input.Type,
+		UserAssignedIdentities: userAssignedIdentitiesFromList(input.IdentityIds),
+	}
+}
+
+// ToUserAssignedList converts this Identity into a UserAssignedList
+func (i Identity) ToUserAssignedList() (*UserAssignedList, error) {
+	if err := i.Validate(ShapeUserAssignedList); err != nil {
+		return nil, err
+	}
+
+	return &UserAssignedList{
+		Type:        normalizeType(i.Type),
+		IdentityIds: i.identityIdsList(),
+	}, nil
+}
+
+// FromUserAssignedMap converts a UserAssignedMap into an Identity
+func FromUserAssignedMap(input *UserAssignedMap) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:                   input.Type,
+		UserAssignedIdentities: userAssignedIdentitiesFromMap(input.IdentityIds),
+	}
+}
+
+// ToUserAssignedMap converts this Identity into a UserAssignedMap
+func (i Identity) ToUserAssignedMap() (*UserAssignedMap, error) {
+	if err := i.Validate(ShapeUserAssignedMap); err != nil {
+		return nil, err
+	}
+
+	return &UserAssignedMap{
+		Type:        normalizeType(i.Type),
+		IdentityIds: i.identityIdsMap(),
+	}, nil
+}
+
+// FromSystemOrUserAssignedList converts a SystemOrUserAssignedList into an Identity
+func FromSystemOrUserAssignedList(input *SystemOrUserAssignedList) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:                   input.Type,
+		PrincipalId:            input.PrincipalId,
+		TenantId:               input.TenantId,
+		UserAssignedIdentities: userAssignedIdentitiesFromList(input.IdentityIds),
+	}
+}
+
+// ToSystemOrUserAssignedList converts this Identity into a SystemOrUserAssignedList
+func (i Identity) ToSystemOrUserAssignedList() (*SystemOrUserAssignedList, error) {
+	if err := i.Validate(ShapeSystemOrUserAssignedList); err != nil {
+		return nil, err
+	}
+
+	return &SystemOrUserAssignedList{
+		Type:        normalizeType(i.Type),
+		PrincipalId: i.PrincipalId,
+		TenantId:    i.TenantId,
+		IdentityIds: i.identityIdsList(),
+	}, nil
+}
+
+// FromSystemOrUserAssignedMap converts a SystemOrUserAssignedMap into an Identity
+func FromSystemOrUserAssignedMap(input *SystemOrUserAssignedMap) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:                   input.Type,
+		PrincipalId:            input.PrincipalId,
+		TenantId:               input.TenantId,
+		UserAssignedIdentities: userAssignedIdentitiesFromMap(input.IdentityIds),
+	}
+}
+
+// ToSystemOrUserAssignedMap converts this Identity into a SystemOrUserAssignedMap
+func (i Identity) ToSystemOrUserAssignedMap() (*SystemOrUserAssignedMap, error) {
+	if err := i.Validate(ShapeSystemOrUserAssignedMap); err != nil {
+		return nil, err
+	}
+
+	return &SystemOrUserAssignedMap{
+		Type:        normalizeType(i.Type),
+		PrincipalId: i.PrincipalId,
+		TenantId:    i.TenantId,
+		IdentityIds: i.identityIdsMap(),
+	}, nil
+}
+
+// FromSystemAndUserAssignedList converts a SystemAndUserAssignedList into an Identity
+func FromSystemAndUserAssignedList(input *SystemAndUserAssignedList) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:                   input.Type,
+		PrincipalId:            input.PrincipalId,
+		TenantId:               input.TenantId,
+		UserAssignedIdentities: userAssignedIdentitiesFromList(input.IdentityIds),
+	}
+}
+
+// ToSystemAndUserAssignedList converts this Identity into a SystemAndUserAssignedList
+func (i Identity) ToSystemAndUserAssignedList() (*SystemAndUserAssignedList, error) {
+	if err := i.Validate(ShapeSystemAndUserAssignedList); err != nil {
+		return nil, err
+	}
+
+	return &SystemAndUserAssignedList{
+		Type:        normalizeType(i.Type),
+		PrincipalId: i.PrincipalId,
+		TenantId:    i.TenantId,
+		IdentityIds: i.identityIdsList(),
+	}, nil
+}
+
+// FromSystemAndUserAssignedMap converts a SystemAndUserAssignedMap into an Identity
+func FromSystemAndUserAssignedMap(input *SystemAndUserAssignedMap) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:                   input.Type,
+		PrincipalId:            input.PrincipalId,
+		TenantId:               input.TenantId,
+		UserAssignedIdentities: userAssignedIdentitiesFromMap(input.IdentityIds),
+	}
+}
+
+// ToSystemAndUserAssignedMap converts this Identity into a SystemAndUserAssignedMap
+func (i Identity) ToSystemAndUserAssignedMap() (*SystemAndUserAssignedMap, error) {
+	if err := i.Validate(ShapeSystemAndUserAssignedMap); err != nil {
+		return nil, err
+	}
+
+	return &SystemAndUserAssignedMap{
+		Type:        normalizeType(i.Type),
+		PrincipalId: i.PrincipalId,
+		TenantId:    i.TenantId,
+		IdentityIds: i.identityIdsMap(),
+	}, nil
+}
+
+// FromLegacySystemAndUserAssignedList converts a LegacySystemAndUserAssignedList into an Identity
+func FromLegacySystemAndUserAssignedList(input *LegacySystemAndUserAssignedList) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:                   input.Type,
+		PrincipalId:            input.PrincipalId,
+		TenantId:               input.TenantId,
+		UserAssignedIdentities: userAssignedIdentitiesFromList(input.IdentityIds),
+	}
+}
+
+// ToLegacySystemAndUserAssignedList converts this Identity into a LegacySystemAndUserAssignedList
+func (i Identity) ToLegacySystemAndUserAssignedList() (*LegacySystemAndUserAssignedList, error) {
+	if err := i.Validate(ShapeLegacySystemAndUserAssignedList); err != nil {
+		return nil, err
+	}
+
+	return &LegacySystemAndUserAssignedList{
+		Type:        normalizeType(i.Type),
+		PrincipalId: i.PrincipalId,
+		TenantId:    i.TenantId,
+		IdentityIds: i.identityIdsList(),
+	}, nil
+}
+
+// FromLegacySystemAndUserAssignedMap converts a LegacySystemAndUserAssignedMap into an Identity
+func FromLegacySystemAndUserAssignedMap(input *LegacySystemAndUserAssignedMap) Identity {
+	if input == nil {
+		return Identity{Type: TypeNone}
+	}
+
+	return Identity{
+		Type:                   input.Type,
+		PrincipalId:            input.PrincipalId,
+		TenantId:               input.TenantId,
+		UserAssignedIdentities: userAssignedIdentitiesFromMap(input.IdentityIds),
+	}
+}
+
+// ToLegacySystemAndUserAssignedMap converts this Identity into a LegacySystemAndUserAssignedMap
+func (i Identity) ToLegacySystemAndUserAssignedMap() (*LegacySystemAndUserAssignedMap, error) {
+	if err := i.Validate(ShapeLegacySystemAndUserAssignedMap); err != nil {
+		return nil, err
+	}
+
+	return &LegacySystemAndUserAssignedMap{
+		Type:        normalizeType(i.Type),
+		PrincipalId: i.PrincipalId,
+		TenantId:    i.TenantId,
+		IdentityIds: i.identityIdsMap(),
+	}, nil
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ExpandIdentity expands the schema input from `commonschema.IdentitySchema` into an Identity, which
+// can then be converted into the Shape used by the API, e.g. using ToSystemAndUserAssignedMap
+func ExpandIdentity(input []interface{}) (*Identity, error) {
+	identityType := TypeNone
+	identityIds := make([]string, 0)
+
+	if len(input) > 0 && input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		switch typeRaw := raw["type"].(string); typeRaw {
+		case string(TypeSystemAssigned), string(TypeUserAssigned), string(TypeSystemAssignedUserAssigned):
+			identityType = Type(typeRaw)
+		}
+
+		// `identity_ids` is only present in the schema when a User Assigned Identity is supported
+		if v, ok := raw["identity_ids"].(*schema.Set); ok {
+			for _, id := range v.List() {
+				identityIds = append(identityIds, id.(string))
+			}
+		}
+	}
+
+	return expandIdentity(identityType, identityIds)
+}
+
+// FlattenIdentity turns an Identity into a []interface{} matching `commonschema.IdentitySchema` - `validTypes` must
+// match the Types the schema was built with so that only the fields present in the schema are returned.
+func FlattenIdentity(input *Identity, validTypes ...Type) (*[]interface{}, error) {
+	if input == nil {
+		return &[]interface{}{}, nil
+	}
+
+	identityType := normalizeType(input.Type)
+	if identityType != TypeSystemAssigned && identityType != TypeUserAssigned && identityType != TypeSystemAssignedUserAssigned {
+		return &[]interface{}{}, nil
+	}
+
+	output := map[string]interface{}{
+		"type": string(identityType),
+	}
+
+	supportsSystemAssigned, supportsUserAssigned := SchemaSupports(validTypes...)
+	if supportsUserAssigned {
+		identityIds, err := flattenIdentityIds(input.IdentityIds())
+		if err != nil {
+			return nil, err
+		}
+		output["identity_ids"] = identityIds
+	}
+	if supportsSystemAssigned {
+		output["principal_id"] = input.PrincipalId
+		output["tenant_id"] = input.TenantId
+	}
+
+	return &[]interface{}{
+		output,
+	}, nil
+}
+
+// ExpandIdentityFromModel expands the typed schema input into an Identity
+func ExpandIdentityFromModel(input []ModelSystemAssignedUserAssigned) (*Identity, error) {
+	if len(input) == 0 {
+		return &Identity{
+			Type: TypeNone,
+		}, nil
+	}
+
+	return expandIdentity(input[0].Type, input[0].IdentityIds)
+}
+
+// FlattenIdentityToModel turns an Identity into a typed schema model - `validTypes` must match the Types the
+// schema was built with, fields which aren't present in the schema are left empty.
+func FlattenIdentityToModel(input *Identity, validTypes ...Type) (*[]ModelSystemAssignedUserAssigned, error) {
+	if input == nil {
+		return &[]ModelSystemAssignedUserAssigned{}, nil
+	}
+
+	identityType := normalizeType(input.Type)
+	if identityType != TypeSystemAssigned && identityType != TypeUserAssigned && identityType != TypeSystemAssignedUserAssigned {
+		return &[]ModelSystemAssignedUserAssigned{}, nil
+	}
+
+	output := ModelSystemAssignedUserAssigned{
+		Type: identityType,
+	}
+
+	supportsSystemAssigned, supportsUserAssigned := SchemaSupports(validTypes...)
+	if supportsUserAssigned {
+		identityIds, err := flattenIdentityIds(input.IdentityIds())
+		if err != nil {
+			return nil, err
+		}
+		output.IdentityIds = identityIds
+	}
+	if supportsSystemAssigned {
+		output.PrincipalId = input.PrincipalId
+		output.TenantId = input.TenantId
+	}
+
+	return &[]ModelSystemAssignedUserAssigned{
+		output,
+	}, nil
+}
+
+// SchemaSupports returns whether the `principal_id`/`tenant_id` fields (for a System Assigned Identity) and the
+// `identity_ids` field (for a User Assigned Identity) are present in an Identity schema supporting `validTypes`,
+// omitting `validTypes` matches the default of `commonschema.IdentitySchema` which supports both.
+func SchemaSupports(validTypes ...Type) (supportsSystemAssigned bool, supportsUserAssigned bool) {
+	if len(validTypes) == 0 {
+		return true, true
+	}
+
+	for _, v := range validTypes {
+		switch normalizeType(v) {
+		case TypeSystemAssigned:
+			supportsSystemAssigned = true
+		case TypeUserAssigned:
+			supportsUserAssigned = true
+		case TypeSystemAssignedUserAssigned:
+			supportsSystemAssigned = true
+			supportsUserAssigned = true
+		}
+	}
+
+	return supportsSystemAssigned, supportsUserAssigned
+}
+
+func expandIdentity(identityType Type, identityIds []string) (*Identity, error) {
+	if len(identityIds) > 0 && identityType != TypeSystemAssignedUserAssigned && identityType != TypeUserAssigned {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` is set to %q or %q", string(TypeSystemAssignedUserAssigned), string(TypeUserAssigned))
+	}
+
+	canonical, err := expandIdentityIds(identityIds)
+	if err != nil {
+		return nil, err
+	}
+
+	userAssignedIdentities := make([]UserAssignedIdentity, 0, len(canonical))
+	for _, v := range canonical {
+		userAssignedIdentities = append(userAssignedIdentities, UserAssignedIdentity{
+			ResourceId: v,
+		})
+	}
+
+	return &Identity{
+		Type:                   identityType,
+		UserAssignedIdentities: userAssignedIdentities,
+	}, nil
+}
+
+func flattenIdentityIds(input []string) ([]string, error) {
+	output := make([]string, 0)
+	for _, raw := range input {
+		id, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
+		}
+		output = append(output, id.ID())
+	}
+
+	return output, nil
+}
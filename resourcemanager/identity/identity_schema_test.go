@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandIdentity(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    []interface{}
+		expected *Identity
+		wantErr  bool
+	}{
+		{
+			name:  "empty",
+			input: []interface{}{},
+			expected: &Identity{
+				Type:                   TypeNone,
+				UserAssignedIdentities: []UserAssignedIdentity{},
+			},
+		},
+		{
+			name: "system assigned without identity_ids in the schema",
+			input: []interface{}{
+				map[string]interface{}{
+					"type": "SystemAssigned",
+				},
+			},
+			expected: &Identity{
+				Type:                   TypeSystemAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{},
+			},
+		},
+		{
+			name: "system and user assigned",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         "SystemAssigned, UserAssigned",
+					"identity_ids": schema.NewSet(schema.HashString, []interface{}{testIdentityIdApiCasing}),
+				},
+			},
+			expected: &Identity{
+				Type: TypeSystemAssignedUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{
+						ResourceId: testIdentityIdNormalized,
+					},
+				},
+			},
+		},
+		{
+			name: "identity_ids without a user assigned type",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         "SystemAssigned",
+					"identity_ids": schema.NewSet(schema.HashString, []interface{}{testIdentityIdNormalized}),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate identity_ids",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         "UserAssigned",
+					"identity_ids": schema.NewSet(schema.HashString, []interface{}{testIdentityIdApiCasing, testIdentityIdNormalized}),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := ExpandIdentity(v.input)
+		if err != nil {
+			if v.wantErr {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.wantErr {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestFlattenIdentity(t *testing.T) {
+	input := &Identity{
+		Type:        typeLegacySystemAssignedUserAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+		UserAssignedIdentities: []UserAssignedIdentity{
+			{
+				ResourceId: testIdentityIdApiCasing,
+			},
+		},
+	}
+
+	flattened, err := FlattenIdentity(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"type":         "SystemAssigned, UserAssigned",
+			"identity_ids": []string{testIdentityIdNormalized},
+			"principal_id": "11111111-1111-1111-1111-111111111111",
+			"tenant_id":    "22222222-2222-2222-2222-222222222222",
+		},
+	}
+	if !reflect.DeepEqual(expected, *flattened) {
+		t.Fatalf("expected %+v but got %+v", expected, *flattened)
+	}
+
+	model, err := FlattenIdentityToModel(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expanded, err := ExpandIdentityFromModel(*model)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if expanded.Type != TypeSystemAssignedUserAssigned || !reflect.DeepEqual(expanded.IdentityIds(), []string{testIdentityIdNormalized}) {
+		t.Fatalf("expected the model to round-trip but got %+v", expanded)
+	}
+
+	flattened, err = FlattenIdentity(input, TypeUserAssigned)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected = []interface{}{
+		map[string]interface{}{
+			"type":         "SystemAssigned, UserAssigned",
+			"identity_ids": []string{testIdentityIdNormalized},
+		},
+	}
+	if !reflect.DeepEqual(expected, *flattened) {
+		t.Fatalf("expected only the User Assigned fields %+v but got %+v", expected, *flattened)
+	}
+
+	model, err = FlattenIdentityToModel(input, TypeSystemAssigned)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len((*model)[0].IdentityIds) != 0 || (*model)[0].PrincipalId != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected only the System Assigned fields to be populated but got %+v", *model)
+	}
+
+	flattened, err = FlattenIdentity(&Identity{Type: TypeNone})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(*flattened) != 0 {
+		t.Fatalf("expected no items when flattening None but got %+v", *flattened)
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestIdentityRoundTripSystemAssigned(t *testing.T) {
+	testData := []SystemAssigned{
+		{},
+		{
+			Type: TypeNone,
+		},
+		{
+			Type:        TypeSystemAssigned,
+			PrincipalId: "11111111-1111-1111-1111-111111111111",
+			TenantId:    "22222222-2222-2222-2222-222222222222",
+		},
+	}
+	for i, input := range testData {
+		actual, err := FromSystemAssigned(&input).ToSystemAssigned()
+		if err != nil {
+			t.Fatalf("index %d: unexpected error: %+v", i, err)
+		}
+		if !reflect.DeepEqual(input, *actual) {
+			t.Fatalf("index %d: expected %+v but got %+v", i, input, *actual)
+		}
+	}
+}
+
+func TestIdentityRoundTripLists(t *testing.T) {
+	identityIds := []string{
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first",
+	}
+
+	userAssigned := UserAssignedList{
+		Type:        TypeUserAssigned,
+		IdentityIds: identityIds,
+	}
+	actualUserAssigned, err := FromUserAssignedList(&userAssigned).ToUserAssignedList()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(userAssigned, *actualUserAssigned) {
+		t.Fatalf("expected %+v but got %+v", userAssigned, *actualUserAssigned)
+	}
+
+	systemOrUserAssigned := SystemOrUserAssignedList{
+		Type:        TypeUserAssigned,
+		IdentityIds: identityIds,
+	}
+	actualSystemOrUserAssigned, err := FromSystemOrUserAssignedList(&systemOrUserAssigned).ToSystemOrUserAssignedList()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(systemOrUserAssigned, *actualSystemOrUserAssigned) {
+		t.Fatalf("expected %+v but got %+v", systemOrUserAssigned, *actualSystemOrUserAssigned)
+	}
+
+	systemAndUserAssigned := SystemAndUserAssignedList{
+		Type:        TypeSystemAssignedUserAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+		IdentityIds: identityIds,
+	}
+	actualSystemAndUserAssigned, err := FromSystemAndUserAssignedList(&systemAndUserAssigned).ToSystemAndUserAssignedList()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(systemAndUserAssigned, *actualSystemAndUserAssigned) {
+		t.Fatalf("expected %+v but got %+v", systemAndUserAssigned, *actualSystemAndUserAssigned)
+	}
+
+	legacy := LegacySystemAndUserAssignedList{
+		Type:        TypeSystemAssignedUserAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+		IdentityIds: identityIds,
+	}
+	actualLegacy, err := FromLegacySystemAndUserAssignedList(&legacy).ToLegacySystemAndUserAssignedList()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(legacy, *actualLegacy) {
+		t.Fatalf("expected %+v but got %+v", legacy, *actualLegacy)
+	}
+}
+
+func TestIdentityRoundTripMaps(t *testing.T) {
+	identityIds := map[string]UserAssignedIdentityDetails{
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first": {
+			ClientId:    pointer.To("33333333-3333-3333-3333-333333333333"),
+			PrincipalId: pointer.To("44444444-4444-4444-4444-444444444444"),
+		},
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second": {},
+	}
+
+	userAssigned := UserAssignedMap{
+		Type:        TypeUserAssigned,
+		IdentityIds: identityIds,
+	}
+	actualUserAssigned, err := FromUserAssignedMap(&userAssigned).ToUserAssignedMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(userAssigned, *actualUserAssigned) {
+		t.Fatalf("expected %+v but got %+v", userAssigned, *actualUserAssigned)
+	}
+
+	systemOrUserAssigned := SystemOrUserAssignedMap{
+		Type:        TypeSystemAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+	}
+	actualSystemOrUserAssigned, err := FromSystemOrUserAssignedMap(&systemOrUserAssigned).ToSystemOrUserAssignedMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(systemOrUserAssigned, *actualSystemOrUserAssigned) {
+		t.Fatalf("expected %+v but got %+v", systemOrUserAssigned, *actualSystemOrUserAssigned)
+	}
+
+	systemAndUserAssigned := SystemAndUserAssignedMap{
+		Type:        TypeSystemAssignedUserAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+		IdentityIds: identityIds,
+	}
+	actualSystemAndUserAssigned, err := FromSystemAndUserAssignedMap(&systemAndUserAssigned).ToSystemAndUserAssignedMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(systemAndUserAssigned, *actualSystemAndUserAssigned) {
+		t.Fatalf("expected %+v but got %+v", systemAndUserAssigned, *actualSystemAndUserAssigned)
+	}
+
+	legacy := LegacySystemAndUserAssignedMap{
+		Type:        TypeSystemAssignedUserAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+		IdentityIds: identityIds,
+	}
+	actualLegacy, err := FromLegacySystemAndUserAssignedMap(&legacy).ToLegacySystemAndUserAssignedMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(legacy, *actualLegacy) {
+		t.Fatalf("expected %+v but got %+v", legacy, *actualLegacy)
+	}
+}
+
+func TestIdentityConvertBetweenShapes(t *testing.T) {
+	input := SystemAndUserAssignedMap{
+		Type:        TypeSystemAssignedUserAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+		IdentityIds: map[string]UserAssignedIdentityDetails{
+			"second": {},
+			"first":  {},
+		},
+	}
+
+	actual, err := FromSystemAndUserAssignedMap(&input).ToLegacySystemAndUserAssignedList()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected := LegacySystemAndUserAssignedList{
+		Type:        TypeSystemAssignedUserAssigned,
+		PrincipalId: "11111111-1111-1111-1111-111111111111",
+		TenantId:    "22222222-2222-2222-2222-222222222222",
+		IdentityIds: []string{"first", "second"},
+	}
+	if !reflect.DeepEqual(expected, *actual) {
+		t.Fatalf("expected %+v but got %+v", expected, *actual)
+	}
+}
+
+func TestIdentityNormalizesLegacyType(t *testing.T) {
+	input := Identity{
+		Type: typeLegacySystemAssignedUserAssigned,
+	}
+	actual, err := input.ToSystemAndUserAssignedMap()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual.Type != TypeSystemAssignedUserAssigned {
+		t.Fatalf("expected the type to be %q but got %q", string(TypeSystemAssignedUserAssigned), string(actual.Type))
+	}
+}
+
+func TestIdentityValidate(t *testing.T) {
+	testData := []struct {
+		input             Identity
+		shape             Shape
+		expectedErrorText string
+	}{
+		{
+			input: Identity{},
+			shape: ShapeSystemAssigned,
+		},
+		{
+			input: Identity{Type: TypeNone},
+			shape: ShapeUserAssignedMap,
+		},
+		{
+			input:             Identity{Type: TypeUserAssigned},
+			shape:             ShapeSystemAssigned,
+			expectedErrorText: `the identity type "UserAssigned" cannot be represented by the shape "SystemAssigned", which supports "None", "SystemAssigned" but not "UserAssigned", "SystemAssigned, UserAssigned"`,
+		},
+		{
+			input:             Identity{Type: TypeSystemAssigned},
+			shape:             ShapeUserAssignedList,
+			expectedErrorText: `not "SystemAssigned", "SystemAssigned, UserAssigned"`,
+		},
+		{
+			input:             Identity{Type: TypeSystemAssignedUserAssigned},
+			shape:             ShapeSystemOrUserAssignedMap,
+			expectedErrorText: `not "SystemAssigned, UserAssigned"`,
+		},
+		{
+			input: Identity{Type: TypeSystemAssignedUserAssigned},
+			shape: ShapeLegacySystemAndUserAssignedMap,
+		},
+		{
+			input: Identity{Type: typeLegacySystemAssignedUserAssigned},
+			shape: ShapeSystemAndUserAssignedList,
+		},
+		{
+			input:             Identity{Type: "Invalid"},
+			shape:             ShapeSystemAndUserAssignedList,
+			expectedErrorText: `the identity type "Invalid" cannot be represented`,
+		},
+		{
+			input: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: "first"},
+					{ResourceId: "first"},
+				},
+			},
+			shape: ShapeUserAssignedList,
+		},
+		{
+			input: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: "first"},
+					{ResourceId: "first"},
+				},
+			},
+			shape:             ShapeUserAssignedMap,
+			expectedErrorText: `the User Assigned Identity "first" was specified more than once`,
+		},
+		{
+			input: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first"},
+					{ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/First"},
+				},
+			},
+			shape:             ShapeSystemAndUserAssignedMap,
+			expectedErrorText: `/userAssignedIdentities/First" was specified more than once`,
+		},
+		{
+			input: Identity{
+				Type: TypeSystemAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: "first"},
+				},
+			},
+			shape:             ShapeSystemAssigned,
+			expectedErrorText: `the User Assigned Identities ["first"] cannot be represented by the shape "SystemAssigned"`,
+		},
+		{
+			input: Identity{
+				Type:                   TypeSystemAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{},
+			},
+			shape: ShapeSystemAssigned,
+		},
+	}
+	for i, v := range testData {
+		err := v.input.Validate(v.shape)
+		if v.expectedErrorText == "" {
+			if err != nil {
+				t.Fatalf("index %d: unexpected error: %+v", i, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("index %d: expected an error but didn't get one", i)
+		}
+		if !strings.Contains(err.Error(), v.expectedErrorText) {
+			t.Fatalf("index %d: expected the error to contain %q but got %q", i, v.expectedErrorText, err.Error())
+		}
+	}
+}
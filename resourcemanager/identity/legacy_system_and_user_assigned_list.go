@@ -12,6 +12,7 @@ import (
 )
 
 var _ json.Marshaler = &LegacySystemAndUserAssignedList{}
+var _ json.Unmarshaler = &LegacySystemAndUserAssignedList{}
 
 type LegacySystemAndUserAssignedList struct {
 	Type        Type     `json:"type" tfschema:"type"`
@@ -54,6 +55,25 @@ func (s LegacySystemAndUserAssignedList) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+func (s *LegacySystemAndUserAssignedList) UnmarshalJSON(input []byte) error {
+	if input == nil {
+		return nil
+	}
+
+	type alias LegacySystemAndUserAssignedList
+	var decoded alias
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling LegacySystemAndUserAssignedList: %+v", err)
+	}
+
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.IdentityIds = normalizeIdentityIdsList(decoded.IdentityIds)
+	s.PrincipalId = decoded.PrincipalId
+	s.TenantId = decoded.TenantId
+
+	return nil
+}
+
 // ExpandLegacySystemAndUserAssignedList expands the schema input into a LegacySystemAndUserAssignedList struct
 func ExpandLegacySystemAndUserAssignedList(input []interface{}) (*LegacySystemAndUserAssignedList, error) {
 	identityType := TypeNone
@@ -76,6 +96,12 @@ func ExpandLegacySystemAndUserAssignedList(input []interface{}) (*LegacySystemAn
 		for _, v := range identityIdsRaw {
 			identityIds = append(identityIds, v.(string))
 		}
+
+		canonical, err := expandIdentityIds(identityIds)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = canonical
 	}
 
 	if len(identityIds) > 0 && (identityType != TypeSystemAssignedUserAssigned && identityType != TypeUserAssigned) {
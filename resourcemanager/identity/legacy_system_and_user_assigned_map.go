@@ -6,13 +6,13 @@ package identity
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var _ json.Marshaler = &LegacySystemAndUserAssignedMap{}
+var _ json.Unmarshaler = &LegacySystemAndUserAssignedMap{}
 
 type LegacySystemAndUserAssignedMap struct {
 	Type        Type                                   `json:"type" tfschema:"type"`
@@ -60,34 +60,14 @@ func (s *LegacySystemAndUserAssignedMap) UnmarshalJSON(input []byte) error {
 		return nil
 	}
 
-	var temp map[string]interface{}
-	if err := json.Unmarshal(input, &temp); err != nil {
-		return fmt.Errorf("unmarshaling LegacySystemAndUserAssignedMap into map[string]interface: %+v", err)
-	}
-	typeVal := TypeNone
-	if v, ok := temp["type"].(string); ok && v != "" {
-		if strings.EqualFold(v, string(TypeSystemAssigned)) {
-			typeVal = TypeSystemAssigned
-		}
-		if strings.EqualFold(v, string(TypeUserAssigned)) {
-			typeVal = TypeUserAssigned
-		}
-		if strings.EqualFold(v, string(typeLegacySystemAssignedUserAssigned)) {
-			typeVal = TypeSystemAssignedUserAssigned
-		}
-		if strings.EqualFold(v, string(TypeSystemAssignedUserAssigned)) {
-			typeVal = TypeSystemAssignedUserAssigned
-		}
-	}
-
 	type alias LegacySystemAndUserAssignedMap
 	var decoded alias
 	if err := json.Unmarshal(input, &decoded); err != nil {
-		return fmt.Errorf("unmarshaling: %+v", err)
+		return fmt.Errorf("unmarshaling LegacySystemAndUserAssignedMap: %+v", err)
 	}
 
-	s.Type = typeVal
-	s.IdentityIds = decoded.IdentityIds
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.IdentityIds = normalizeIdentityIdsMap(decoded.IdentityIds)
 	s.PrincipalId = decoded.PrincipalId
 	s.TenantId = decoded.TenantId
 
@@ -112,9 +92,16 @@ func ExpandLegacySystemAndUserAssignedMap(input []interface{}) (*LegacySystemAnd
 			identityType = TypeUserAssigned
 		}
 
-		identityIdsRaw := raw["identity_ids"].(*schema.Set).List()
-		for _, v := range identityIdsRaw {
-			identityIds[v.(string)] = UserAssignedIdentityDetails{
+		identityIdsRaw := make([]string, 0)
+		for _, v := range raw["identity_ids"].(*schema.Set).List() {
+			identityIdsRaw = append(identityIdsRaw, v.(string))
+		}
+		canonical, err := expandIdentityIds(identityIdsRaw)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range canonical {
+			identityIds[v] = UserAssignedIdentityDetails{
 				// intentionally empty since the expand shouldn't send these values
 			}
 		}
@@ -176,7 +163,11 @@ func ExpandLegacySystemAndUserAssignedMapFromModel(input []ModelSystemAssignedUs
 
 	identityType := input[0].Type
 	identityIds := make(map[string]UserAssignedIdentityDetails, 0)
-	for _, v := range input[0].IdentityIds {
+	canonical, err := expandIdentityIds(input[0].IdentityIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range canonical {
 		identityIds[v] = UserAssignedIdentityDetails{
 			// intentionally empty since the expand shouldn't send these values
 		}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// normalizeTypeFromAPI normalizes the `type` returned from the API (including the legacy
+// `SystemAssigned,UserAssigned` value) into a known Type, returning TypeNone for unknown values
+func normalizeTypeFromAPI(input Type) Type {
+	switch v := normalizeType(input); v {
+	case TypeSystemAssigned, TypeUserAssigned, TypeSystemAssignedUserAssigned:
+		return v
+	}
+
+	return TypeNone
+}
+
+// normalizeIdentityId recases the User Assigned Identity ID `input` when it can be parsed, otherwise it's returned as-is
+func normalizeIdentityId(input string) string {
+	id, err := commonids.ParseUserAssignedIdentityIDInsensitively(input)
+	if err != nil {
+		return input
+	}
+
+	return id.ID()
+}
+
+func normalizeIdentityIdsList(input []string) []string {
+	if input == nil {
+		return nil
+	}
+
+	out := make([]string, 0, len(input))
+	for _, v := range input {
+		out = append(out, normalizeIdentityId(v))
+	}
+	return out
+}
+
+func normalizeIdentityIdsMap(input map[string]UserAssignedIdentityDetails) map[string]UserAssignedIdentityDetails {
+	if input == nil {
+		return nil
+	}
+
+	// process the keys in a consistent order, so that if the API returns the same ID in differing casings
+	// the same entry is retained each time
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make(map[string]UserAssignedIdentityDetails, len(input))
+	for _, k := range keys {
+		key := normalizeIdentityId(k)
+		if _, exists := out[key]; exists {
+			continue
+		}
+		out[key] = input[k]
+	}
+	return out
+}
+
+// CanonicalizeIdentityIds recases each of the User Assigned Identity IDs in `input` and removes any IDs which
+// differ only by casing, returning the canonical IDs in their original order along with the duplicates which
+// were removed
+func CanonicalizeIdentityIds(input []string) (ids []string, duplicates []string) {
+	if input == nil {
+		return nil, nil
+	}
+
+	ids = make([]string, 0, len(input))
+	duplicates = make([]string, 0)
+	seen := make(map[string]struct{}, len(input))
+	for _, v := range input {
+		id := normalizeIdentityId(v)
+		key := strings.ToLower(id)
+		if _, exists := seen[key]; exists {
+			duplicates = append(duplicates, v)
+			continue
+		}
+		seen[key] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	return ids, duplicates
+}
+
+// expandIdentityIds canonicalizes the User Assigned Identity IDs specified in the configuration, returning an
+// error if the same User Assigned Identity was specified more than once
+func expandIdentityIds(input []string) ([]string, error) {
+	ids, duplicates := CanonicalizeIdentityIds(input)
+	if len(duplicates) > 0 {
+		return nil, fmt.Errorf("the User Assigned Identities %q were specified more than once in `identity_ids` (User Assigned Identity IDs are case-insensitive)", duplicates)
+	}
+
+	return ids, nil
+}
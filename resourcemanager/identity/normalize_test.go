@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+const (
+	testIdentityIdApiCasing  = "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/first"
+	testIdentityIdNormalized = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first"
+)
+
+func TestUnmarshalNormalizesType(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected Type
+	}{
+		{
+			input:    `{}`,
+			expected: TypeNone,
+		},
+		{
+			input:    `{"type": "none"}`,
+			expected: TypeNone,
+		},
+		{
+			input:    `{"type": "unknown"}`,
+			expected: TypeNone,
+		},
+		{
+			input:    `{"type": "systemassigned"}`,
+			expected: TypeSystemAssigned,
+		},
+		{
+			input:    `{"type": "userAssigned"}`,
+			expected: TypeUserAssigned,
+		},
+		{
+			input:    `{"type": "SystemAssigned,UserAssigned"}`,
+			expected: TypeSystemAssignedUserAssigned,
+		},
+		{
+			input:    `{"type": "systemAssigned, userAssigned"}`,
+			expected: TypeSystemAssignedUserAssigned,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		targets := map[string]func() (any, func() Type){
+			"SystemAssigned": func() (any, func() Type) {
+				out := &SystemAssigned{}
+				return out, func() Type { return out.Type }
+			},
+			"UserAssignedList": func() (any, func() Type) {
+				out := &UserAssignedList{}
+				return out, func() Type { return out.Type }
+			},
+			"UserAssignedMap": func() (any, func() Type) {
+				out := &UserAssignedMap{}
+				return out, func() Type { return out.Type }
+			},
+			"SystemOrUserAssignedList": func() (any, func() Type) {
+				out := &SystemOrUserAssignedList{}
+				return out, func() Type { return out.Type }
+			},
+			"SystemOrUserAssignedMap": func() (any, func() Type) {
+				out := &SystemOrUserAssignedMap{}
+				return out, func() Type { return out.Type }
+			},
+			"SystemAndUserAssignedList": func() (any, func() Type) {
+				out := &SystemAndUserAssignedList{}
+				return out, func() Type { return out.Type }
+			},
+			"SystemAndUserAssignedMap": func() (any, func() Type) {
+				out := &SystemAndUserAssignedMap{}
+				return out, func() Type { return out.Type }
+			},
+			"LegacySystemAndUserAssignedList": func() (any, func() Type) {
+				out := &LegacySystemAndUserAssignedList{}
+				return out, func() Type { return out.Type }
+			},
+			"LegacySystemAndUserAssignedMap": func() (any, func() Type) {
+				out := &LegacySystemAndUserAssignedMap{}
+				return out, func() Type { return out.Type }
+			},
+		}
+		for name, target := range targets {
+			out, actual := target()
+			if err := json.Unmarshal([]byte(v.input), out); err != nil {
+				t.Fatalf("%s: unmarshaling: %+v", name, err)
+			}
+			if actual() != v.expected {
+				t.Fatalf("%s: expected %q but got %q", name, string(v.expected), string(actual()))
+			}
+		}
+	}
+}
+
+func TestUnmarshalMapNormalizesIdentityIds(t *testing.T) {
+	input := `{
+	  "type": "SystemAssigned,UserAssigned",
+	  "principalId": "11111111-1111-1111-1111-111111111111",
+	  "tenantId": "22222222-2222-2222-2222-222222222222",
+	  "userAssignedIdentities": {
+	    "` + testIdentityIdApiCasing + `": {
+	      "clientId": "33333333-3333-3333-3333-333333333333",
+	      "principalId": "44444444-4444-4444-4444-444444444444"
+	    },
+	    "not-a-resource-id": {}
+	  }
+	}`
+	expectedIdentityIds := map[string]UserAssignedIdentityDetails{
+		testIdentityIdNormalized: {
+			ClientId:    pointer.To("33333333-3333-3333-3333-333333333333"),
+			PrincipalId: pointer.To("44444444-4444-4444-4444-444444444444"),
+		},
+		"not-a-resource-id": {},
+	}
+
+	var systemAndUserAssigned SystemAndUserAssignedMap
+	if err := json.Unmarshal([]byte(input), &systemAndUserAssigned); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if systemAndUserAssigned.PrincipalId != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the principalId to be decoded but got %q", systemAndUserAssigned.PrincipalId)
+	}
+	if systemAndUserAssigned.TenantId != "22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("expected the tenantId to be decoded but got %q", systemAndUserAssigned.TenantId)
+	}
+	if !reflect.DeepEqual(expectedIdentityIds, systemAndUserAssigned.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expectedIdentityIds, systemAndUserAssigned.IdentityIds)
+	}
+
+	var legacy LegacySystemAndUserAssignedMap
+	if err := json.Unmarshal([]byte(input), &legacy); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if !reflect.DeepEqual(expectedIdentityIds, legacy.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expectedIdentityIds, legacy.IdentityIds)
+	}
+
+	var userAssigned UserAssignedMap
+	if err := json.Unmarshal([]byte(input), &userAssigned); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if !reflect.DeepEqual(expectedIdentityIds, userAssigned.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expectedIdentityIds, userAssigned.IdentityIds)
+	}
+
+	var systemOrUserAssigned SystemOrUserAssignedMap
+	if err := json.Unmarshal([]byte(input), &systemOrUserAssigned); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if !reflect.DeepEqual(expectedIdentityIds, systemOrUserAssigned.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expectedIdentityIds, systemOrUserAssigned.IdentityIds)
+	}
+
+	// the details are retained when unmarshaling, but must still be omitted when marshaling
+	encoded, err := json.Marshal(systemAndUserAssigned)
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	expected := `{"type":"SystemAssigned, UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first":{},"not-a-resource-id":{}}}`
+	if string(encoded) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(encoded))
+	}
+}
+
+func TestUnmarshalMapDuplicateIdentityIds(t *testing.T) {
+	input := `{
+	  "type": "UserAssigned",
+	  "userAssignedIdentities": {
+	    "` + testIdentityIdApiCasing + `": {},
+	    "` + testIdentityIdNormalized + `": {}
+	  }
+	}`
+
+	var out UserAssignedMap
+	if err := json.Unmarshal([]byte(input), &out); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	expected := map[string]UserAssignedIdentityDetails{
+		testIdentityIdNormalized: {},
+	}
+	if !reflect.DeepEqual(expected, out.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expected, out.IdentityIds)
+	}
+}
+
+func TestUnmarshalListNormalizesIdentityIds(t *testing.T) {
+	input := `{
+	  "type": "UserAssigned",
+	  "userAssignedIdentities": ["` + testIdentityIdApiCasing + `", "not-a-resource-id"]
+	}`
+	expected := []string{
+		testIdentityIdNormalized,
+		"not-a-resource-id",
+	}
+
+	var userAssigned UserAssignedList
+	if err := json.Unmarshal([]byte(input), &userAssigned); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, userAssigned.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expected, userAssigned.IdentityIds)
+	}
+
+	var systemOrUserAssigned SystemOrUserAssignedList
+	if err := json.Unmarshal([]byte(input), &systemOrUserAssigned); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, systemOrUserAssigned.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expected, systemOrUserAssigned.IdentityIds)
+	}
+
+	var systemAndUserAssigned SystemAndUserAssignedList
+	if err := json.Unmarshal([]byte(input), &systemAndUserAssigned); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, systemAndUserAssigned.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expected, systemAndUserAssigned.IdentityIds)
+	}
+
+	var legacy LegacySystemAndUserAssignedList
+	if err := json.Unmarshal([]byte(input), &legacy); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, legacy.IdentityIds) {
+		t.Fatalf("expected %+v but got %+v", expected, legacy.IdentityIds)
+	}
+}
+
+func TestCanonicalizeIdentityIds(t *testing.T) {
+	ids, duplicates := CanonicalizeIdentityIds([]string{
+		testIdentityIdApiCasing,
+		"not-a-resource-id",
+		testIdentityIdNormalized,
+		"NOT-A-RESOURCE-ID",
+	})
+	expectedIds := []string{
+		testIdentityIdNormalized,
+		"not-a-resource-id",
+	}
+	if !reflect.DeepEqual(expectedIds, ids) {
+		t.Fatalf("expected %+v but got %+v", expectedIds, ids)
+	}
+	expectedDuplicates := []string{
+		testIdentityIdNormalized,
+		"NOT-A-RESOURCE-ID",
+	}
+	if !reflect.DeepEqual(expectedDuplicates, duplicates) {
+		t.Fatalf("expected %+v but got %+v", expectedDuplicates, duplicates)
+	}
+
+	if ids, _ := CanonicalizeIdentityIds(nil); ids != nil {
+		t.Fatalf("expected nil when canonicalizing nil but got %+v", ids)
+	}
+}
+
+func TestExpandCaseInsensitiveDuplicateIdentityIds(t *testing.T) {
+	duplicated := []string{testIdentityIdApiCasing, testIdentityIdNormalized}
+
+	if _, err := ExpandUserAssignedListFromModel([]ModelUserAssigned{{Type: TypeUserAssigned, IdentityIds: duplicated}}); err == nil {
+		t.Fatalf("expected an error for UserAssignedList but didn't get one")
+	}
+	if _, err := ExpandUserAssignedMapFromModel([]ModelUserAssigned{{Type: TypeUserAssigned, IdentityIds: duplicated}}); err == nil {
+		t.Fatalf("expected an error for UserAssignedMap but didn't get one")
+	}
+	if _, err := ExpandSystemAndUserAssignedMapFromModel([]ModelSystemAssignedUserAssigned{{Type: TypeUserAssigned, IdentityIds: duplicated}}); err == nil {
+		t.Fatalf("expected an error for SystemAndUserAssignedMap but didn't get one")
+	}
+	if _, err := ExpandLegacySystemAndUserAssignedMapFromModel([]ModelSystemAssignedUserAssigned{{Type: TypeUserAssigned, IdentityIds: duplicated}}); err == nil {
+		t.Fatalf("expected an error for LegacySystemAndUserAssignedMap but didn't get one")
+	}
+
+	expanded, err := ExpandSystemAndUserAssignedMapFromModel([]ModelSystemAssignedUserAssigned{{Type: TypeUserAssigned, IdentityIds: []string{testIdentityIdApiCasing}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if _, ok := expanded.IdentityIds[testIdentityIdNormalized]; !ok || len(expanded.IdentityIds) != 1 {
+		t.Fatalf("expected the Identity ID to be canonicalized but got %+v", expanded.IdentityIds)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+var _ json.Marshaler = Patch{}
+
+// Patch is the minimal payload required to update a Managed Identity using a PATCH request, as returned from Diff.
+type Patch struct {
+	// Type is the desired Type of the Managed Identity
+	Type Type
+
+	// UserAssignedIdentities contains the User Assigned Identities which are being changed, where
+	// a User Assigned Identity which is being added has an empty value and a User Assigned Identity
+	// which is being removed has a nil value (which is sent to the API as `null`).
+	UserAssignedIdentities map[string]*UserAssignedIdentityDetails
+
+	// UseLegacyType specifies that the legacy `SystemAssigned,UserAssigned` value (without a space)
+	// should be sent to the API, for APIs which use the LegacySystemAndUserAssigned types.
+	UseLegacyType bool
+
+	// existingType is the Type of the existing Identity, used to determine whether the Type has changed
+	existingType Type
+}
+
+// Diff compares the `existing` and `desired` Identities and returns the Patch required to
+// update the `existing` Identity to the `desired` Identity.
+//
+// User Assigned Identities are compared case-insensitively, and any User Assigned Identities
+// which are assigned to both Identities are omitted from the Patch. When the `desired` Type doesn't
+// include `UserAssigned`, the User Assigned Identities are removed by the change in Type and so are
+// omitted from the Patch.
+func Diff(existing Identity, desired Identity) Patch {
+	out := Patch{
+		Type:                   TypeNone,
+		UserAssignedIdentities: map[string]*UserAssignedIdentityDetails{},
+		existingType:           TypeNone,
+	}
+
+	if v := normalizeType(desired.Type); v != "" {
+		out.Type = v
+	}
+	if v := normalizeType(existing.Type); v != "" {
+		out.existingType = v
+	}
+	if !typeIncludesUserAssigned(out.Type) {
+		return out
+	}
+
+	existingIds := make(map[string]string)
+	if typeIncludesUserAssigned(out.existingType) {
+		for _, v := range existing.UserAssignedIdentities {
+			existingIds[strings.ToLower(v.ResourceId)] = v.ResourceId
+		}
+	}
+
+	desiredIds := make(map[string]struct{})
+	for _, v := range desired.UserAssignedIdentities {
+		key := strings.ToLower(v.ResourceId)
+		desiredIds[key] = struct{}{}
+
+		if _, ok := existingIds[key]; !ok {
+			out.UserAssignedIdentities[v.ResourceId] = &UserAssignedIdentityDetails{
+				// intentionally empty since these values can't be sent to the API
+			}
+		}
+	}
+
+	for key, id := range existingIds {
+		if _, ok := desiredIds[key]; !ok {
+			out.UserAssignedIdentities[id] = nil
+		}
+	}
+
+	return out
+}
+
+// HasChanges returns whether this Patch changes either the Type or any User Assigned Identities
+func (p Patch) HasChanges() bool {
+	return p.Type != p.existingType || len(p.UserAssignedIdentities) > 0
+}
+
+func (p Patch) MarshalJSON() ([]byte, error) {
+	identityType := TypeNone
+	switch normalizeType(p.Type) {
+	case TypeSystemAssigned:
+		identityType = TypeSystemAssigned
+	case TypeUserAssigned:
+		identityType = TypeUserAssigned
+	case TypeSystemAssignedUserAssigned:
+		identityType = TypeSystemAssignedUserAssigned
+		if p.UseLegacyType {
+			identityType = typeLegacySystemAssignedUserAssigned
+		}
+	}
+
+	out := map[string]interface{}{
+		"type": string(identityType),
+	}
+	if typeIncludesUserAssigned(normalizeType(p.Type)) && len(p.UserAssignedIdentities) > 0 {
+		out["userAssignedIdentities"] = p.UserAssignedIdentities
+	}
+	return json.Marshal(out)
+}
+
+func typeIncludesUserAssigned(input Type) bool {
+	return input == TypeUserAssigned || input == TypeSystemAssignedUserAssigned
+}
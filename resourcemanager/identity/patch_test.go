@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const (
+	testPatchIdentityFirst  = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first"
+	testPatchIdentitySecond = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second"
+)
+
+func TestDiffTypeTransitions(t *testing.T) {
+	testData := []struct {
+		name            string
+		existing        Identity
+		desired         Identity
+		expectedJSON    string
+		expectedChanges bool
+	}{
+		{
+			name:            "None to None",
+			existing:        Identity{Type: TypeNone, UserAssignedIdentities: nil},
+			desired:         Identity{Type: TypeNone, UserAssignedIdentities: nil},
+			expectedJSON:    `{"type":"None"}`,
+			expectedChanges: false,
+		},
+		{
+			name:            "None to SystemAssigned",
+			existing:        Identity{Type: TypeNone, UserAssignedIdentities: nil},
+			desired:         Identity{Type: TypeSystemAssigned, UserAssignedIdentities: nil},
+			expectedJSON:    `{"type":"SystemAssigned"}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "None to UserAssigned",
+			existing:        Identity{Type: TypeNone, UserAssignedIdentities: nil},
+			desired:         Identity{Type: TypeUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentitySecond}}},
+			expectedJSON:    `{"type":"UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second":{}}}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "None to SystemAssigned, UserAssigned",
+			existing:        Identity{Type: TypeNone, UserAssignedIdentities: nil},
+			desired:         Identity{Type: TypeSystemAssignedUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentitySecond}}},
+			expectedJSON:    `{"type":"SystemAssigned, UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second":{}}}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "SystemAssigned to None",
+			existing:        Identity{Type: TypeSystemAssigned, UserAssignedIdentities: nil},
+			desired:         Identity{Type: TypeNone, UserAssignedIdentities: nil},
+			expectedJSON:    `{"type":"None"}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "SystemAssigned to SystemAssigned",
+			existing:        Identity{Type: TypeSystemAssigned, UserAssignedIdentities: nil},
+			desired:         Identity{Type: TypeSystemAssigned, UserAssignedIdentities: nil},
+			expectedJSON:    `{"type":"SystemAssigned"}`,
+			expectedChanges: false,
+		},
+		{
+			name:            "SystemAssigned to UserAssigned",
+			existing:        Identity{Type: TypeSystemAssigned, UserAssignedIdentities: nil},
+			desired:         Identity{Type: TypeUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentitySecond}}},
+			expectedJSON:    `{"type":"UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second":{}}}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "SystemAssigned to SystemAssigned, UserAssigned",
+			existing:        Identity{Type: TypeSystemAssigned, UserAssignedIdentities: nil},
+			desired:         Identity{Type: TypeSystemAssignedUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentitySecond}}},
+			expectedJSON:    `{"type":"SystemAssigned, UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second":{}}}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "UserAssigned to None",
+			existing:        Identity{Type: TypeUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentityFirst}}},
+			desired:         Identity{Type: TypeNone, UserAssignedIdentities: nil},
+			expectedJSON:    `{"type":"None"}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "UserAssigned to SystemAssigned",
+			existing:        Identity{Type: TypeUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentityFirst}}},
+			desired:         Identity{Type: TypeSystemAssigned, UserAssignedIdentities: nil},
+			expectedJSON:    `{"type":"SystemAssigned"}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "UserAssigned to UserAssigned",
+			existing:        Identity{Type: TypeUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentityFirst}}},
+			desired:         Identity{Type: TypeUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentitySecond}}},
+			expectedJSON:    `{"type":"UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first":null,"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second":{}}}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "UserAssigned to SystemAssigned, UserAssigned",
+			existing:        Identity{Type: TypeUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentityFirst}}},
+			desired:         Identity{Type: TypeSystemAssignedUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentitySecond}}},
+			expectedJSON:    `{"type":"SystemAssigned, UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first":null,"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second":{}}}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "SystemAssigned, UserAssigned to None",
+			existing:        Identity{Type: TypeSystemAssignedUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentityFirst}}},
+			desired:         Identity{Type: TypeNone, UserAssignedIdentities: nil},
+			expectedJSON:    `{"type":"None"}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "SystemAssigned, UserAssigned to SystemAssigned",
+			existing:        Identity{Type: TypeSystemAssignedUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentityFirst}}},
+			desired:         Identity{Type: TypeSystemAssigned, UserAssignedIdentities: nil},
+			expectedJSON:    `{"type":"SystemAssigned"}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "SystemAssigned, UserAssigned to UserAssigned",
+			existing:        Identity{Type: TypeSystemAssignedUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentityFirst}}},
+			desired:         Identity{Type: TypeUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentitySecond}}},
+			expectedJSON:    `{"type":"UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first":null,"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second":{}}}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "SystemAssigned, UserAssigned to SystemAssigned, UserAssigned",
+			existing:        Identity{Type: TypeSystemAssignedUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentityFirst}}},
+			desired:         Identity{Type: TypeSystemAssignedUserAssigned, UserAssignedIdentities: []UserAssignedIdentity{{ResourceId: testPatchIdentitySecond}}},
+			expectedJSON:    `{"type":"SystemAssigned, UserAssigned","userAssignedIdentities":{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first":null,"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second":{}}}`,
+			expectedChanges: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s", v.name)
+
+		patch := Diff(v.existing, v.desired)
+		encoded, err := json.Marshal(patch)
+		if err != nil {
+			t.Fatalf("marshaling: %+v", err)
+		}
+		if string(encoded) != v.expectedJSON {
+			t.Fatalf("expected %s but got %s", v.expectedJSON, string(encoded))
+		}
+		if patch.HasChanges() != v.expectedChanges {
+			t.Fatalf("expected HasChanges to be %t but got %t", v.expectedChanges, patch.HasChanges())
+		}
+	}
+}
+
+func TestDiffUserAssignedIdentities(t *testing.T) {
+	testData := []struct {
+		name            string
+		existing        Identity
+		desired         Identity
+		expectedJSON    string
+		expectedChanges bool
+	}{
+		{
+			name: "unchanged",
+			existing: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentityFirst},
+				},
+			},
+			desired: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentityFirst},
+				},
+			},
+			expectedJSON:    `{"type":"UserAssigned"}`,
+			expectedChanges: false,
+		},
+		{
+			name: "unchanged with different casing",
+			existing: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/first"},
+				},
+			},
+			desired: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentityFirst},
+				},
+			},
+			expectedJSON:    `{"type":"UserAssigned"}`,
+			expectedChanges: false,
+		},
+		{
+			name: "added",
+			existing: Identity{
+				Type: TypeSystemAssignedUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentityFirst},
+				},
+			},
+			desired: Identity{
+				Type: TypeSystemAssignedUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentityFirst},
+					{ResourceId: testPatchIdentitySecond},
+				},
+			},
+			expectedJSON:    `{"type":"SystemAssigned, UserAssigned","userAssignedIdentities":{"` + testPatchIdentitySecond + `":{}}}`,
+			expectedChanges: true,
+		},
+		{
+			name: "removed",
+			existing: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentityFirst},
+					{ResourceId: testPatchIdentitySecond},
+				},
+			},
+			desired: Identity{
+				Type: TypeUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentitySecond},
+				},
+			},
+			expectedJSON:    `{"type":"UserAssigned","userAssignedIdentities":{"` + testPatchIdentityFirst + `":null}}`,
+			expectedChanges: true,
+		},
+		{
+			name:            "empty types are treated as None",
+			existing:        Identity{},
+			desired:         Identity{},
+			expectedJSON:    `{"type":"None"}`,
+			expectedChanges: false,
+		},
+		{
+			name: "legacy type from the API",
+			existing: Identity{
+				Type: typeLegacySystemAssignedUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentityFirst},
+				},
+			},
+			desired: Identity{
+				Type: TypeSystemAssignedUserAssigned,
+				UserAssignedIdentities: []UserAssignedIdentity{
+					{ResourceId: testPatchIdentityFirst},
+				},
+			},
+			expectedJSON:    `{"type":"SystemAssigned, UserAssigned"}`,
+			expectedChanges: false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s", v.name)
+
+		patch := Diff(v.existing, v.desired)
+		encoded, err := json.Marshal(patch)
+		if err != nil {
+			t.Fatalf("marshaling: %+v", err)
+		}
+		if string(encoded) != v.expectedJSON {
+			t.Fatalf("expected %s but got %s", v.expectedJSON, string(encoded))
+		}
+		if patch.HasChanges() != v.expectedChanges {
+			t.Fatalf("expected HasChanges to be %t but got %t", v.expectedChanges, patch.HasChanges())
+		}
+	}
+}
+
+func TestPatchMarshalLegacyType(t *testing.T) {
+	patch := Diff(Identity{Type: TypeSystemAssigned}, Identity{
+		Type: TypeSystemAssignedUserAssigned,
+		UserAssignedIdentities: []UserAssignedIdentity{
+			{ResourceId: testPatchIdentityFirst},
+		},
+	})
+	patch.UseLegacyType = true
+
+	encoded, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	expected := `{"type":"SystemAssigned,UserAssigned","userAssignedIdentities":{"` + testPatchIdentityFirst + `":{}}}`
+	if string(encoded) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(encoded))
+	}
+}
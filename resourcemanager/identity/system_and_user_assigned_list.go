@@ -12,6 +12,7 @@ import (
 )
 
 var _ json.Marshaler = &SystemAndUserAssignedList{}
+var _ json.Unmarshaler = &SystemAndUserAssignedList{}
 
 type SystemAndUserAssignedList struct {
 	Type        Type     `json:"type" tfschema:"type"`
@@ -49,6 +50,25 @@ func (s SystemAndUserAssignedList) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+func (s *SystemAndUserAssignedList) UnmarshalJSON(input []byte) error {
+	if input == nil {
+		return nil
+	}
+
+	type alias SystemAndUserAssignedList
+	var decoded alias
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling SystemAndUserAssignedList: %+v", err)
+	}
+
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.IdentityIds = normalizeIdentityIdsList(decoded.IdentityIds)
+	s.PrincipalId = decoded.PrincipalId
+	s.TenantId = decoded.TenantId
+
+	return nil
+}
+
 // ExpandSystemAndUserAssignedList expands the schema input into a SystemAndUserAssignedList struct
 func ExpandSystemAndUserAssignedList(input []interface{}) (*SystemAndUserAssignedList, error) {
 	identityType := TypeNone
@@ -71,6 +91,12 @@ func ExpandSystemAndUserAssignedList(input []interface{}) (*SystemAndUserAssigne
 		for _, v := range identityIdsRaw {
 			identityIds = append(identityIds, v.(string))
 		}
+
+		canonical, err := expandIdentityIds(identityIds)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = canonical
 	}
 
 	if len(identityIds) > 0 && (identityType != TypeSystemAssignedUserAssigned && identityType != TypeUserAssigned) {
@@ -128,9 +154,14 @@ func ExpandSystemAndUserAssignedListFromModel(input []ModelSystemAssignedUserAss
 		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` is set to %q or %q", TypeSystemAssignedUserAssigned, TypeUserAssigned)
 	}
 
+	identityIds, err := expandIdentityIds(identity.IdentityIds)
+	if err != nil {
+		return nil, err
+	}
+
 	return &SystemAndUserAssignedList{
 		Type:        identity.Type,
-		IdentityIds: identity.IdentityIds,
+		IdentityIds: identityIds,
 	}, nil
 }
 
@@ -12,6 +12,7 @@ import (
 )
 
 var _ json.Marshaler = &SystemAndUserAssignedMap{}
+var _ json.Unmarshaler = &SystemAndUserAssignedMap{}
 
 type SystemAndUserAssignedMap struct {
 	Type        Type                                   `json:"type" tfschema:"type"`
@@ -49,6 +50,25 @@ func (s SystemAndUserAssignedMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+func (s *SystemAndUserAssignedMap) UnmarshalJSON(input []byte) error {
+	if input == nil {
+		return nil
+	}
+
+	type alias SystemAndUserAssignedMap
+	var decoded alias
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling SystemAndUserAssignedMap: %+v", err)
+	}
+
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.IdentityIds = normalizeIdentityIdsMap(decoded.IdentityIds)
+	s.PrincipalId = decoded.PrincipalId
+	s.TenantId = decoded.TenantId
+
+	return nil
+}
+
 // ExpandSystemAndUserAssignedMap expands the schema input into a SystemAndUserAssignedMap struct
 func ExpandSystemAndUserAssignedMap(input []interface{}) (*SystemAndUserAssignedMap, error) {
 	identityType := TypeNone
@@ -67,9 +87,16 @@ func ExpandSystemAndUserAssignedMap(input []interface{}) (*SystemAndUserAssigned
 			identityType = TypeUserAssigned
 		}
 
-		identityIdsRaw := raw["identity_ids"].(*schema.Set).List()
-		for _, v := range identityIdsRaw {
-			identityIds[v.(string)] = UserAssignedIdentityDetails{
+		identityIdsRaw := make([]string, 0)
+		for _, v := range raw["identity_ids"].(*schema.Set).List() {
+			identityIdsRaw = append(identityIdsRaw, v.(string))
+		}
+		canonical, err := expandIdentityIds(identityIdsRaw)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range canonical {
+			identityIds[v] = UserAssignedIdentityDetails{
 				// intentionally empty since the expand shouldn't send these values
 			}
 		}
@@ -128,7 +155,11 @@ func ExpandSystemAndUserAssignedMapFromModel(input []ModelSystemAssignedUserAssi
 	identity := input[0]
 
 	identityIds := make(map[string]UserAssignedIdentityDetails, len(identity.IdentityIds))
-	for _, v := range identity.IdentityIds {
+	canonical, err := expandIdentityIds(identity.IdentityIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range canonical {
 		identityIds[v] = UserAssignedIdentityDetails{
 			// intentionally empty since the expand shouldn't send these values
 		}
@@ -5,9 +5,11 @@ package identity
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 var _ json.Marshaler = &SystemAssigned{}
+var _ json.Unmarshaler = &SystemAssigned{}
 
 type SystemAssigned struct {
 	Type        Type   `json:"type" tfschema:"type"`
@@ -26,6 +28,24 @@ func (s SystemAssigned) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+func (s *SystemAssigned) UnmarshalJSON(input []byte) error {
+	if input == nil {
+		return nil
+	}
+
+	type alias SystemAssigned
+	var decoded alias
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling SystemAssigned: %+v", err)
+	}
+
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.PrincipalId = decoded.PrincipalId
+	s.TenantId = decoded.TenantId
+
+	return nil
+}
+
 func ExpandSystemAssigned(input []interface{}) (*SystemAssigned, error) {
 	if len(input) == 0 || input[0] == nil {
 		return &SystemAssigned{
@@ -12,6 +12,7 @@ import (
 )
 
 var _ json.Marshaler = &SystemOrUserAssignedList{}
+var _ json.Unmarshaler = &SystemOrUserAssignedList{}
 
 type SystemOrUserAssignedList struct {
 	Type        Type     `json:"type" tfschema:"type"`
@@ -46,6 +47,25 @@ func (s SystemOrUserAssignedList) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+func (s *SystemOrUserAssignedList) UnmarshalJSON(input []byte) error {
+	if input == nil {
+		return nil
+	}
+
+	type alias SystemOrUserAssignedList
+	var decoded alias
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling SystemOrUserAssignedList: %+v", err)
+	}
+
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.IdentityIds = normalizeIdentityIdsList(decoded.IdentityIds)
+	s.PrincipalId = decoded.PrincipalId
+	s.TenantId = decoded.TenantId
+
+	return nil
+}
+
 // ExpandSystemOrUserAssignedList expands the schema input into a SystemOrUserAssignedList struct
 func ExpandSystemOrUserAssignedList(input []interface{}) (*SystemOrUserAssignedList, error) {
 	identityType := TypeNone
@@ -65,6 +85,12 @@ func ExpandSystemOrUserAssignedList(input []interface{}) (*SystemOrUserAssignedL
 		for _, v := range identityIdsRaw {
 			identityIds = append(identityIds, v.(string))
 		}
+
+		canonical, err := expandIdentityIds(identityIds)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = canonical
 	}
 
 	if len(identityIds) > 0 && identityType != TypeUserAssigned {
@@ -122,9 +148,14 @@ func ExpandSystemOrUserAssignedListFromModel(input []ModelSystemAssignedUserAssi
 		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` is set to %q", TypeUserAssigned)
 	}
 
+	identityIds, err := expandIdentityIds(identity.IdentityIds)
+	if err != nil {
+		return nil, err
+	}
+
 	return &SystemOrUserAssignedList{
 		Type:        identity.Type,
-		IdentityIds: identity.IdentityIds,
+		IdentityIds: identityIds,
 	}, nil
 }
 
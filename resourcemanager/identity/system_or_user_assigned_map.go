@@ -12,6 +12,7 @@ import (
 )
 
 var _ json.Marshaler = &SystemOrUserAssignedMap{}
+var _ json.Unmarshaler = &SystemOrUserAssignedMap{}
 
 type SystemOrUserAssignedMap struct {
 	Type        Type                                   `json:"type" tfschema:"type"`
@@ -46,6 +47,25 @@ func (s SystemOrUserAssignedMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+func (s *SystemOrUserAssignedMap) UnmarshalJSON(input []byte) error {
+	if input == nil {
+		return nil
+	}
+
+	type alias SystemOrUserAssignedMap
+	var decoded alias
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling SystemOrUserAssignedMap: %+v", err)
+	}
+
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.IdentityIds = normalizeIdentityIdsMap(decoded.IdentityIds)
+	s.PrincipalId = decoded.PrincipalId
+	s.TenantId = decoded.TenantId
+
+	return nil
+}
+
 // ExpandSystemOrUserAssignedMap expands the schema input into a SystemOrUserAssignedMap struct
 func ExpandSystemOrUserAssignedMap(input []interface{}) (*SystemOrUserAssignedMap, error) {
 	identityType := TypeNone
@@ -61,9 +81,16 @@ func ExpandSystemOrUserAssignedMap(input []interface{}) (*SystemOrUserAssignedMa
 			identityType = TypeUserAssigned
 		}
 
-		identityIdsRaw := raw["identity_ids"].(*schema.Set).List()
-		for _, v := range identityIdsRaw {
-			identityIds[v.(string)] = UserAssignedIdentityDetails{
+		identityIdsRaw := make([]string, 0)
+		for _, v := range raw["identity_ids"].(*schema.Set).List() {
+			identityIdsRaw = append(identityIdsRaw, v.(string))
+		}
+		canonical, err := expandIdentityIds(identityIdsRaw)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range canonical {
+			identityIds[v] = UserAssignedIdentityDetails{
 				// intentionally empty since the expand shouldn't send these values
 			}
 		}
@@ -123,7 +150,11 @@ func ExpandSystemOrUserAssignedMapFromModel(input []ModelSystemAssignedUserAssig
 	identity := input[0]
 
 	identityIds := make(map[string]UserAssignedIdentityDetails, len(identity.IdentityIds))
-	for _, v := range identity.IdentityIds {
+	canonical, err := expandIdentityIds(identity.IdentityIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range canonical {
 		identityIds[v] = UserAssignedIdentityDetails{
 			// intentionally empty since the expand shouldn't send these values
 		}
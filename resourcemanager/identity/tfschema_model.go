@@ -20,3 +20,24 @@ type ModelSystemAssignedUserAssigned struct {
 	TenantId    string   `tfschema:"tenant_id"`
 	IdentityIds []string `tfschema:"identity_ids"`
 }
+
+type ModelUserAssignedIdentityDetails struct {
+	Id          string `tfschema:"id"`
+	ClientId    string `tfschema:"client_id"`
+	PrincipalId string `tfschema:"principal_id"`
+}
+
+type ModelDelegatedResource struct {
+	Name             string `tfschema:"name"`
+	Location         string `tfschema:"location"`
+	ReferralResource string `tfschema:"referral_resource"`
+	ResourceId       string `tfschema:"resource_id"`
+	TenantId         string `tfschema:"tenant_id"`
+}
+
+type ModelFederatedIdentityCredential struct {
+	Name      string   `tfschema:"name"`
+	Issuer    string   `tfschema:"issuer"`
+	Subject   string   `tfschema:"subject"`
+	Audiences []string `tfschema:"audiences"`
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// NOTE: the `user_assigned_identities` field is an optional, Computed-only addition to the Identity schema which
+// exposes the Client ID and Principal ID of each User Assigned Identity - and is only available for the Map types
+// since the List types don't return these values.
+
+// FlattenUserAssignedIdentityDetails turns the User Assigned Identities within a Map type into a []interface{}
+// for use in the `user_assigned_identities` field, sorted by the (normalized) User Assigned Identity ID.
+func FlattenUserAssignedIdentityDetails(input map[string]UserAssignedIdentityDetails) (*[]interface{}, error) {
+	models, err := FlattenUserAssignedIdentityDetailsToModel(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *models {
+		output = append(output, map[string]interface{}{
+			"id":           v.Id,
+			"client_id":    v.ClientId,
+			"principal_id": v.PrincipalId,
+		})
+	}
+
+	return &output, nil
+}
+
+// FlattenUserAssignedIdentityDetailsToModel turns the User Assigned Identities within a Map type into a typed
+// schema model, sorted by the (normalized) User Assigned Identity ID.
+func FlattenUserAssignedIdentityDetailsToModel(input map[string]UserAssignedIdentityDetails) (*[]ModelUserAssignedIdentityDetails, error) {
+	output := make([]ModelUserAssignedIdentityDetails, 0)
+	for raw, details := range input {
+		id, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
+		}
+
+		output = append(output, ModelUserAssignedIdentityDetails{
+			Id:          id.ID(),
+			ClientId:    pointer.From(details.ClientId),
+			PrincipalId: pointer.From(details.PrincipalId),
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Id < output[j].Id
+	})
+
+	return &output, nil
+}
+
+// FlattenUserAssignedMapWithDetails turns a UserAssignedMap into a []interface{}, including the
+// `user_assigned_identities` field
+func FlattenUserAssignedMapWithDetails(input *UserAssignedMap) (*[]interface{}, error) {
+	output, err := FlattenUserAssignedMap(input)
+	if err != nil || input == nil {
+		return output, err
+	}
+
+	return appendUserAssignedIdentityDetails(output, input.IdentityIds)
+}
+
+// FlattenSystemOrUserAssignedMapWithDetails turns a SystemOrUserAssignedMap into a []interface{}, including the
+// `user_assigned_identities` field
+func FlattenSystemOrUserAssignedMapWithDetails(input *SystemOrUserAssignedMap) (*[]interface{}, error) {
+	output, err := FlattenSystemOrUserAssignedMap(input)
+	if err != nil || input == nil {
+		return output, err
+	}
+
+	return appendUserAssignedIdentityDetails(output, input.IdentityIds)
+}
+
+// FlattenSystemAndUserAssignedMapWithDetails turns a SystemAndUserAssignedMap into a []interface{}, including the
+// `user_assigned_identities` field
+func FlattenSystemAndUserAssignedMapWithDetails(input *SystemAndUserAssignedMap) (*[]interface{}, error) {
+	output, err := FlattenSystemAndUserAssignedMap(input)
+	if err != nil || input == nil {
+		return output, err
+	}
+
+	return appendUserAssignedIdentityDetails(output, input.IdentityIds)
+}
+
+// FlattenLegacySystemAndUserAssignedMapWithDetails turns a LegacySystemAndUserAssignedMap into a []interface{},
+// including the `user_assigned_identities` field
+func FlattenLegacySystemAndUserAssignedMapWithDetails(input *LegacySystemAndUserAssignedMap) (*[]interface{}, error) {
+	output, err := FlattenLegacySystemAndUserAssignedMap(input)
+	if err != nil || input == nil {
+		return output, err
+	}
+
+	return appendUserAssignedIdentityDetails(output, input.IdentityIds)
+}
+
+func appendUserAssignedIdentityDetails(flattened *[]interface{}, identityIds map[string]UserAssignedIdentityDetails) (*[]interface{}, error) {
+	if flattened == nil || len(*flattened) == 0 {
+		return flattened, nil
+	}
+
+	details, err := FlattenUserAssignedIdentityDetails(identityIds)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := (*flattened)[0].(map[string]interface{})
+	raw["user_assigned_identities"] = *details
+	return flattened, nil
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestFlattenUserAssignedIdentityDetails(t *testing.T) {
+	input := map[string]UserAssignedIdentityDetails{
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/second": {},
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first": {
+			ClientId:    pointer.To("11111111-1111-1111-1111-111111111111"),
+			PrincipalId: pointer.To("22222222-2222-2222-2222-222222222222"),
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first",
+			"client_id":    "11111111-1111-1111-1111-111111111111",
+			"principal_id": "22222222-2222-2222-2222-222222222222",
+		},
+		map[string]interface{}{
+			"id":           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/second",
+			"client_id":    "",
+			"principal_id": "",
+		},
+	}
+
+	actual, err := FlattenUserAssignedIdentityDetails(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, *actual) {
+		t.Fatalf("expected %+v but got %+v", expected, *actual)
+	}
+
+	if _, err := FlattenUserAssignedIdentityDetails(map[string]UserAssignedIdentityDetails{"not-a-resource-id": {}}); err == nil {
+		t.Fatalf("expected an error for an invalid User Assigned Identity ID but didn't get one")
+	}
+}
+
+func TestFlattenSystemAndUserAssignedMapWithDetails(t *testing.T) {
+	actual, err := FlattenSystemAndUserAssignedMapWithDetails(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(*actual) != 0 {
+		t.Fatalf("expected no items but got %d", len(*actual))
+	}
+
+	actual, err = FlattenSystemAndUserAssignedMapWithDetails(&SystemAndUserAssignedMap{
+		Type:        TypeSystemAssignedUserAssigned,
+		PrincipalId: "33333333-3333-3333-3333-333333333333",
+		TenantId:    "44444444-4444-4444-4444-444444444444",
+		IdentityIds: map[string]UserAssignedIdentityDetails{
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/first": {
+				ClientId:    pointer.To("11111111-1111-1111-1111-111111111111"),
+				PrincipalId: pointer.To("22222222-2222-2222-2222-222222222222"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"type": "SystemAssigned, UserAssigned",
+			"identity_ids": []string{
+				"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first",
+			},
+			"principal_id": "33333333-3333-3333-3333-333333333333",
+			"tenant_id":    "44444444-4444-4444-4444-444444444444",
+			"user_assigned_identities": []interface{}{
+				map[string]interface{}{
+					"id":           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/first",
+					"client_id":    "11111111-1111-1111-1111-111111111111",
+					"principal_id": "22222222-2222-2222-2222-222222222222",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, *actual) {
+		t.Fatalf("expected %+v but got %+v", expected, *actual)
+	}
+
+	actual, err = FlattenUserAssignedMapWithDetails(&UserAssignedMap{
+		Type: TypeNone,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(*actual) != 0 {
+		t.Fatalf("expected no items but got %d", len(*actual))
+	}
+}
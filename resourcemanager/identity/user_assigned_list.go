@@ -12,6 +12,7 @@ import (
 )
 
 var _ json.Marshaler = &UserAssignedList{}
+var _ json.Unmarshaler = &UserAssignedList{}
 
 type UserAssignedList struct {
 	Type        Type     `json:"type" tfschema:"type"`
@@ -38,6 +39,23 @@ func (s UserAssignedList) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+func (s *UserAssignedList) UnmarshalJSON(input []byte) error {
+	if input == nil {
+		return nil
+	}
+
+	type alias UserAssignedList
+	var decoded alias
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling UserAssignedList: %+v", err)
+	}
+
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.IdentityIds = normalizeIdentityIdsList(decoded.IdentityIds)
+
+	return nil
+}
+
 // ExpandUserAssignedList expands the schema input into a UserAssignedList struct
 func ExpandUserAssignedList(input []interface{}) (*UserAssignedList, error) {
 	identityType := TypeNone
@@ -54,6 +72,12 @@ func ExpandUserAssignedList(input []interface{}) (*UserAssignedList, error) {
 		for _, v := range identityIdsRaw {
 			identityIds = append(identityIds, v.(string))
 		}
+
+		canonical, err := expandIdentityIds(identityIds)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = canonical
 	}
 
 	if len(identityIds) > 0 && identityType != TypeUserAssigned {
@@ -105,9 +129,14 @@ func ExpandUserAssignedListFromModel(input []ModelUserAssigned) (*UserAssignedLi
 	}
 
 	identity := input[0]
+	identityIds, err := expandIdentityIds(identity.IdentityIds)
+	if err != nil {
+		return nil, err
+	}
+
 	return &UserAssignedList{
 		Type:        identity.Type,
-		IdentityIds: identity.IdentityIds,
+		IdentityIds: identityIds,
 	}, nil
 }
 
@@ -12,6 +12,7 @@ import (
 )
 
 var _ json.Marshaler = &UserAssignedMap{}
+var _ json.Unmarshaler = &UserAssignedMap{}
 
 type UserAssignedMap struct {
 	Type        Type                                   `json:"type" tfschema:"type"`
@@ -38,6 +39,23 @@ func (s UserAssignedMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+func (s *UserAssignedMap) UnmarshalJSON(input []byte) error {
+	if input == nil {
+		return nil
+	}
+
+	type alias UserAssignedMap
+	var decoded alias
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling UserAssignedMap: %+v", err)
+	}
+
+	s.Type = normalizeTypeFromAPI(decoded.Type)
+	s.IdentityIds = normalizeIdentityIdsMap(decoded.IdentityIds)
+
+	return nil
+}
+
 // ExpandUserAssignedMap expands the schema input into a UserAssignedMap struct
 func ExpandUserAssignedMap(input []interface{}) (*UserAssignedMap, error) {
 	identityType := TypeNone
@@ -50,9 +68,16 @@ func ExpandUserAssignedMap(input []interface{}) (*UserAssignedMap, error) {
 			identityType = TypeUserAssigned
 		}
 
-		identityIdsRaw := raw["identity_ids"].(*schema.Set).List()
-		for _, v := range identityIdsRaw {
-			identityIds[v.(string)] = UserAssignedIdentityDetails{
+		identityIdsRaw := make([]string, 0)
+		for _, v := range raw["identity_ids"].(*schema.Set).List() {
+			identityIdsRaw = append(identityIdsRaw, v.(string))
+		}
+		canonical, err := expandIdentityIds(identityIdsRaw)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range canonical {
+			identityIds[v] = UserAssignedIdentityDetails{
 				// intentionally empty since the expand shouldn't send these values
 			}
 		}
@@ -109,7 +134,11 @@ func ExpandUserAssignedMapFromModel(input []ModelUserAssigned) (*UserAssignedMap
 	identity := input[0]
 
 	identityIds := make(map[string]UserAssignedIdentityDetails, 0)
-	for _, v := range identity.IdentityIds {
+	canonical, err := expandIdentityIds(identity.IdentityIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range canonical {
 		identityIds[v] = UserAssignedIdentityDetails{
 			// intentionally empty since the expand shouldn't send these values
 		}
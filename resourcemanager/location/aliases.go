@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// UnknownLocationError is returned from CanonicalName when the input couldn't be matched to a
+// known Location, either using the alias table or the embedded Catalogue for each Cloud
+type UnknownLocationError struct {
+	// Input is the value which couldn't be matched
+	Input string
+
+	// Normalized is the Normalized version of Input, which is the best-effort value for this Location
+	Normalized string
+}
+
+func (e UnknownLocationError) Error() string {
+	return fmt.Sprintf("%q is not a known Azure Location", e.Input)
+}
+
+var (
+	aliasesLock sync.RWMutex
+
+	// aliases maps the Normalized alias to the canonical name of the Location
+	aliases = map[string]string{
+		// the Azure MetaData Service returns the India locations the wrong way around
+		"indiacentral": "centralindia",
+		"indiasouth":   "southindia",
+		"indiawest":    "westindia",
+	}
+)
+
+// RegisterAlias registers `alias` as an alternative name for the Location `canonical`, which
+// is then used when normalizing a Location via CanonicalName or NormalizeCanonical
+func RegisterAlias(alias, canonical string) {
+	aliasesLock.Lock()
+	defer aliasesLock.Unlock()
+
+	aliases[Normalize(alias)] = Normalize(canonical)
+}
+
+// geographyPrefixRegex matches the Geography prefix within a Location's regional display name,
+// e.g. `(Asia Pacific) Central India`
+var geographyPrefixRegex = regexp.MustCompile(`^\s*\([^)]*\)\s*`)
+
+// CanonicalName returns the canonical name for the Location `input`, which can be the canonical name
+// (e.g. `centralindia`), a display name (e.g. `Central India` or `(Asia Pacific) Central India`) or a
+// registered alias (e.g. `indiacentral`). An UnknownLocationError is returned when the Location isn't known.
+func CanonicalName(input string) (string, error) {
+	normalized := Normalize(geographyPrefixRegex.ReplaceAllString(input, ""))
+	if normalized == "" {
+		return "", UnknownLocationError{
+			Input:      input,
+			Normalized: normalized,
+		}
+	}
+
+	// Some resources use a location named "global".
+	if normalized == "global" {
+		return normalized, nil
+	}
+
+	aliasesLock.RLock()
+	canonical, ok := aliases[normalized]
+	aliasesLock.RUnlock()
+	if ok {
+		return canonical, nil
+	}
+
+	if region, ok := lookupRegionInAnyCloud(normalized); ok {
+		return region.Name, nil
+	}
+
+	return "", UnknownLocationError{
+		Input:      input,
+		Normalized: normalized,
+	}
+}
+
+// NormalizeCanonical returns the canonical name for the Location `input` when this is known, otherwise
+// falling back to the Normalized value - so that Locations which aren't yet known continue to work
+func NormalizeCanonical(input string) string {
+	canonical, err := CanonicalName(input)
+	if err != nil {
+		return Normalize(geographyPrefixRegex.ReplaceAllString(input, ""))
+	}
+
+	return canonical
+}
+
+// NormalizeCanonicalNilable returns the canonical name for the Location even if it's nil to ensure
+// this field can always have a value
+func NormalizeCanonicalNilable(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	return NormalizeCanonical(*input)
+}
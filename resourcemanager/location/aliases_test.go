@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanonicalName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+		unknown  bool
+	}{
+		{
+			input:    "westeurope",
+			expected: "westeurope",
+		},
+		{
+			input:    "West Europe",
+			expected: "westeurope",
+		},
+		{
+			input:    "(Asia Pacific) Central India",
+			expected: "centralindia",
+		},
+		{
+			input:    "indiasouth",
+			expected: "southindia",
+		},
+		{
+			input:    "USGov Virginia",
+			expected: "usgovvirginia",
+		},
+		{
+			input:    "US Gov Virginia",
+			expected: "usgovvirginia",
+		},
+		{
+			input:    "global",
+			expected: "global",
+		},
+		{
+			input:   "Atlantis",
+			unknown: true,
+		},
+		{
+			input:   "",
+			unknown: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := CanonicalName(v.input)
+		if v.unknown {
+			var unknown UnknownLocationError
+			if !errors.As(err, &unknown) {
+				t.Fatalf("expected an UnknownLocationError but got %+v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestRegisterAlias(t *testing.T) {
+	if _, err := CanonicalName("Europe West"); err == nil {
+		t.Fatalf("expected `Europe West` to be unknown before it's registered")
+	}
+
+	RegisterAlias("Europe West", "West Europe")
+	defer func() {
+		aliasesLock.Lock()
+		delete(aliases, "europewest")
+		aliasesLock.Unlock()
+	}()
+
+	actual, err := CanonicalName("europewest")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual != "westeurope" {
+		t.Fatalf("expected %q but got %q", "westeurope", actual)
+	}
+}
+
+func TestNormalizeCanonical(t *testing.T) {
+	if actual := NormalizeCanonical("(Europe) West Europe"); actual != "westeurope" {
+		t.Fatalf("expected %q but got %q", "westeurope", actual)
+	}
+
+	// unknown locations fall back to the normalized value
+	if actual := NormalizeCanonical("Some New Region"); actual != "somenewregion" {
+		t.Fatalf("expected %q but got %q", "somenewregion", actual)
+	}
+
+	if !DiffSuppressFunc("", "indiasouth", "South India", nil) {
+		t.Fatalf("expected the diff between `indiasouth` and `South India` to be suppressed")
+	}
+	if actual := StateFunc("Central India"); actual != "centralindia" {
+		t.Fatalf("expected %q but got %q", "centralindia", actual)
+	}
+}
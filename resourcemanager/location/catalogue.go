@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"embed"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/internal/catalogueloader"
+)
+
+// Cloud is the name of an Azure Cloud which has an embedded Catalogue
+type Cloud string
+
+const (
+	CloudPublic       Cloud = "public"
+	CloudUSGovernment Cloud = "usgovernment"
+	CloudChina        Cloud = "china"
+)
+
+// PossibleValuesForCloud returns the Clouds which have an embedded Catalogue
+func PossibleValuesForCloud() []Cloud {
+	return []Cloud{
+		CloudPublic,
+		CloudUSGovernment,
+		CloudChina,
+	}
+}
+
+// Region describes an Azure Region (Location) within a Cloud
+type Region struct {
+	// Name is the canonical name for this Region, e.g. `westeurope`
+	Name string `json:"name"`
+
+	// DisplayName is the human readable name for this Region, e.g. `West Europe`
+	DisplayName string `json:"displayName"`
+
+	// Geography is the Geography this Region is located within, e.g. `Europe`
+	Geography string `json:"geography"`
+
+	// PairedRegion is the canonical name of the Region this Region is paired with, if any
+	PairedRegion *string `json:"pairedRegion,omitempty"`
+
+	// SupportsAvailabilityZones specifies whether this Region supports Availability Zones
+	SupportsAvailabilityZones bool `json:"supportsAvailabilityZones"`
+
+	// PhysicalZoneCount is the number of physical Availability Zones within this Region
+	PhysicalZoneCount int `json:"physicalZoneCount"`
+}
+
+// Catalogue is an offline list of the Regions available within a Cloud
+type Catalogue struct {
+	// Cloud is the Cloud which this Catalogue describes
+	Cloud Cloud `json:"cloud"`
+
+	// Version is the date which this Catalogue was last updated, in the format `YYYY-MM-DD`
+	Version string `json:"version"`
+
+	// Regions is the list of Regions within this Cloud, sorted by Name
+	Regions []Region `json:"regions"`
+}
+
+// Region returns the Region within this Catalogue matching `input`, which can be either the
+// Name (e.g. `westeurope`) or the DisplayName (e.g. `West Europe`) of the Region
+func (c Catalogue) Region(input string) (*Region, bool) {
+	normalized := Normalize(input)
+	for _, v := range c.Regions {
+		if v.Name == normalized || Normalize(v.DisplayName) == normalized {
+			region := v
+			return &region, true
+		}
+	}
+
+	return nil, false
+}
+
+// Names returns the canonical names of the Regions within this Catalogue
+func (c Catalogue) Names() []string {
+	out := make([]string, 0, len(c.Regions))
+	for _, v := range c.Regions {
+		out = append(out, v.Name)
+	}
+	return out
+}
+
+//go:embed catalogue/*.json
+var catalogueFiles embed.FS
+
+var catalogueLoader = catalogueloader.New(catalogueFiles, "Location", PossibleValuesForCloud(), func(c Catalogue) Cloud {
+	return c.Cloud
+}, func(c *Catalogue) {
+	sort.Slice(c.Regions, func(i, j int) bool {
+		return c.Regions[i].Name < c.Regions[j].Name
+	})
+})
+
+// CatalogueForCloud returns the embedded Catalogue for the specified Cloud
+func CatalogueForCloud(cloud Cloud) (*Catalogue, error) {
+	catalogue, err := catalogueLoader.CatalogueForCloud(cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy the Regions so that the embedded Catalogue can't be modified by callers
+	catalogue.Regions = append([]Region{}, catalogue.Regions...)
+	return catalogue, nil
+}
+
+// LookupRegion returns the Region matching `input` from the embedded Catalogue for the Cloud `cloud`
+func LookupRegion(cloud Cloud, input string) (*Region, bool) {
+	catalogue, err := CatalogueForCloud(cloud)
+	if err != nil {
+		return nil, false
+	}
+
+	return catalogue.Region(input)
+}
+
+// CloudForResourceManagerEndpoint returns the Cloud which has an embedded Catalogue for the specified
+// Resource Manager Endpoint (e.g. `https://management.azure.com/`), if any
+func CloudForResourceManagerEndpoint(resourceManagerEndpoint string) (*Cloud, bool) {
+	endpoint := strings.TrimPrefix(strings.ToLower(resourceManagerEndpoint), "https://")
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	clouds := map[string]Cloud{
+		"management.azure.com":         CloudPublic,
+		"management.usgovcloudapi.net": CloudUSGovernment,
+		"management.chinacloudapi.cn":  CloudChina,
+	}
+	if cloud, ok := clouds[endpoint]; ok {
+		return &cloud, true
+	}
+
+	return nil, false
+}
+
+// lookupRegionInAnyCloud searches the embedded Catalogues for each Cloud for the Region matching `input`,
+// which is used to canonicalise a Location when the Cloud isn't known
+func lookupRegionInAnyCloud(input string) (*Region, bool) {
+	for _, cloud := range PossibleValuesForCloud() {
+		if region, ok := LookupRegion(cloud, input); ok {
+			return region, true
+		}
+	}
+
+	return nil, false
+}
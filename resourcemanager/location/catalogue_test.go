@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestCatalogueIsConsistent(t *testing.T) {
+	versionRegex := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+	for _, cloud := range PossibleValuesForCloud() {
+		catalogue, err := CatalogueForCloud(cloud)
+		if err != nil {
+			t.Fatalf("loading the catalogue for %q: %+v", cloud, err)
+		}
+		if !versionRegex.MatchString(catalogue.Version) {
+			t.Fatalf("expected the catalogue for %q to have a version in the format YYYY-MM-DD but got %q", cloud, catalogue.Version)
+		}
+		if len(catalogue.Regions) == 0 {
+			t.Fatalf("expected the catalogue for %q to contain regions", cloud)
+		}
+
+		for _, region := range catalogue.Regions {
+			if region.Name != Normalize(region.Name) {
+				t.Fatalf("expected the region %q in %q to use the canonical name", region.Name, cloud)
+			}
+			if Normalize(region.DisplayName) != region.Name {
+				t.Fatalf("expected the display name %q to normalize to %q", region.DisplayName, region.Name)
+			}
+			if region.Geography == "" {
+				t.Fatalf("expected the region %q in %q to have a geography", region.Name, cloud)
+			}
+			if region.SupportsAvailabilityZones != (region.PhysicalZoneCount > 0) {
+				t.Fatalf("expected the region %q in %q to have zones only when availability zones are supported", region.Name, cloud)
+			}
+			if region.PairedRegion != nil {
+				if _, ok := catalogue.Region(*region.PairedRegion); !ok {
+					t.Fatalf("the paired region %q for %q was not found in %q", *region.PairedRegion, region.Name, cloud)
+				}
+			}
+		}
+	}
+
+	if _, err := CatalogueForCloud("unknown"); err == nil {
+		t.Fatalf("expected an error for an unknown cloud but didn't get one")
+	}
+}
+
+func TestLookupRegion(t *testing.T) {
+	testData := []struct {
+		cloud        Cloud
+		input        string
+		expectedName string
+	}{
+		{
+			cloud:        CloudPublic,
+			input:        "West Europe",
+			expectedName: "westeurope",
+		},
+		{
+			cloud:        CloudUSGovernment,
+			input:        "usgovvirginia",
+			expectedName: "usgovvirginia",
+		},
+		{
+			cloud:        CloudChina,
+			input:        "China North 3",
+			expectedName: "chinanorth3",
+		},
+		{
+			// the lookup is scoped to the specified Cloud
+			cloud: CloudPublic,
+			input: "chinanorth3",
+		},
+		{
+			cloud: CloudChina,
+			input: "westeurope",
+		},
+		{
+			cloud: CloudPublic,
+			input: "atlantis",
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q in %q", v.input, v.cloud)
+
+		region, ok := LookupRegion(v.cloud, v.input)
+		if v.expectedName == "" {
+			if ok {
+				t.Fatalf("expected %q not to be found but got %+v", v.input, region)
+			}
+			continue
+		}
+		if !ok {
+			t.Fatalf("expected %q to be found", v.input)
+		}
+		if region.Name != v.expectedName {
+			t.Fatalf("expected %q but got %q", v.expectedName, region.Name)
+		}
+	}
+}
+
+func TestCloudForResourceManagerEndpoint(t *testing.T) {
+	testData := map[string]*Cloud{
+		"https://management.azure.com/":                 pointerToCloud(CloudPublic),
+		"management.usgovcloudapi.net":                  pointerToCloud(CloudUSGovernment),
+		"https://management.chinacloudapi.cn":           pointerToCloud(CloudChina),
+		"https://management.local.azurestack.external/": nil,
+	}
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		actual, ok := CloudForResourceManagerEndpoint(input)
+		if expected == nil {
+			if ok {
+				t.Fatalf("expected no Cloud but got %q", *actual)
+			}
+			continue
+		}
+		if !ok || *actual != *expected {
+			t.Fatalf("expected %q but got %+v", *expected, actual)
+		}
+	}
+}
+
+func TestOfflineValidation(t *testing.T) {
+	defaultValidator.Store(nil)
+
+	testData := []struct {
+		input   string
+		warning bool
+	}{
+		{
+			input: "Sweden Central",
+		},
+		{
+			input: "East US 2 EUAP",
+		},
+		{
+			input: "centraluseuap",
+		},
+		{
+			input: "chinanorth3",
+		},
+		{
+			input: "global",
+		},
+		{
+			// Azure Stack
+			input:   "local",
+			warning: true,
+		},
+		{
+			input:   "atlantis",
+			warning: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		warnings, errors := EnhancedValidate(v.input, "location")
+		if len(errors) > 0 {
+			t.Fatalf("expected no errors for %q offline but got %+v", v.input, errors)
+		}
+		if v.warning != (len(warnings) > 0) {
+			t.Fatalf("expected a warning to be %t for %q but got %+v", v.warning, v.input, warnings)
+		}
+	}
+
+	if _, errors := EnhancedValidate("", "location"); len(errors) == 0 {
+		t.Fatalf("expected an empty location to be invalid")
+	}
+}
+
+func TestOfflineValidationScopedToCloud(t *testing.T) {
+	validator := NewOfflineLocationValidator(CloudChina)
+
+	testData := []struct {
+		input   string
+		warning bool
+	}{
+		{
+			input: "China North 3",
+		},
+		{
+			input: "global",
+		},
+		{
+			input:   "westeurope",
+			warning: true,
+		},
+		{
+			input:   "atlantis",
+			warning: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		warnings, errors := validator.ValidateFunc()(v.input, "location")
+		if len(errors) > 0 {
+			t.Fatalf("expected no errors for %q offline but got %+v", v.input, errors)
+		}
+		if v.warning != (len(warnings) > 0) {
+			t.Fatalf("expected a warning to be %t for %q but got %+v", v.warning, v.input, warnings)
+		}
+	}
+}
+
+func pointerToCloud(input Cloud) *Cloud {
+	return &input
+}
+
+func TestCanonicalNameCanaryRegions(t *testing.T) {
+	testData := map[string]string{
+		"East US 2 EUAP":  "eastus2euap",
+		"Central US EUAP": "centraluseuap",
+		"Belgium Central": "belgiumcentral",
+		"Austria East":    "austriaeast",
+	}
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		actual, err := CanonicalName(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != expected {
+			t.Fatalf("expected %q but got %q", expected, actual)
+		}
+	}
+}
@@ -5,11 +5,14 @@ package location
 
 import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+// DiffSuppressFunc suppresses the diff when both Locations have the same canonical name, for
+// example `West Europe` and `westeurope`, or the alias `indiasouth` and `southindia`
 func DiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
-	return Normalize(old) == Normalize(new)
+	return NormalizeCanonical(old) == NormalizeCanonical(new)
 }
 
+// StateFunc stores the canonical name for the Location in the state
 func StateFunc(location interface{}) string {
 	input := location.(string)
-	return Normalize(input)
+	return NormalizeCanonical(input)
 }
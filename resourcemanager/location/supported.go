@@ -6,19 +6,26 @@ package location
 import (
 	"context"
 	"log"
+	"sync/atomic"
 )
 
-// supportedLocations can be (validly) nil - as such this shouldn't be relied on
-var supportedLocations *[]string
+// defaultValidator is the LocationValidator used by EnhancedValidate, which can be (validly) nil
+var defaultValidator atomic.Pointer[LocationValidator]
 
 // CacheSupportedLocations attempts to retrieve the supported locations from the Azure MetaData Service
 // and caches them, for used in enhanced validation
+//
+// Deprecated: this sets the LocationValidator used by EnhancedValidate for all Environments within this
+// process - use NewLocationValidatorFromMetaData to obtain a LocationValidator for a specific Environment.
 func CacheSupportedLocations(ctx context.Context, resourceManagerEndpoint string) {
-	locs, err := availableAzureLocations(ctx, resourceManagerEndpoint)
+	validator, err := NewLocationValidatorFromMetaData(ctx, resourceManagerEndpoint)
 	if err != nil {
-		log.Printf("[DEBUG] error retrieving locations: %s. Enhanced validation will be unavailable", err)
+		log.Printf("[DEBUG] error retrieving locations: %s. Falling back to the offline catalogue", err)
+		if cloud, ok := CloudForResourceManagerEndpoint(resourceManagerEndpoint); ok {
+			defaultValidator.Store(NewOfflineLocationValidator(*cloud))
+		}
 		return
 	}
 
-	supportedLocations = locs.Locations
+	defaultValidator.Store(validator)
 }
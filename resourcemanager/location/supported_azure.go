@@ -61,19 +61,13 @@ func availableAzureLocations(ctx context.Context, resourceManagerEndpoint string
 		}
 	}
 
-	// TODO: remove this once Microsoft fixes the API
-	// the Azure API returns the india locations the wrong way around
-	// e.g. 'southindia' is returned as 'indiasouth'
-	// so we need to conditionally switch these out until Microsoft fixes the API
-	// $ az account list-locations -o table | grep india
-	//  Central India             centralindia         (Asia Pacific) Central India
-	//  South India               southindia           (Asia Pacific) South India
-	//  West India                westindia            (Asia Pacific) West India
+	// the Azure MetaData Service returns some locations using an alias (e.g. `indiasouth` rather
+	// than `southindia`) - so we map these to the canonical name using the alias table
 	if locations != nil {
-		out := *locations
-		out = switchLocationIfExists("indiacentral", "centralindia", out)
-		out = switchLocationIfExists("indiasouth", "southindia", out)
-		out = switchLocationIfExists("indiawest", "westindia", out)
+		out := make([]string, 0, len(*locations))
+		for _, v := range *locations {
+			out = append(out, canonicalizeAlias(v))
+		}
 		locations = &out
 	}
 
@@ -82,14 +76,14 @@ func availableAzureLocations(ctx context.Context, resourceManagerEndpoint string
 	}, nil
 }
 
-func switchLocationIfExists(find, replace string, locations []string) []string {
-	out := locations
+// canonicalizeAlias returns the canonical name for `input` when it's a registered alias, otherwise returning it as-is
+func canonicalizeAlias(input string) string {
+	aliasesLock.RLock()
+	defer aliasesLock.RUnlock()
 
-	for i, v := range out {
-		if v == find {
-			out[i] = replace
-		}
+	if canonical, ok := aliases[Normalize(input)]; ok {
+		return canonical
 	}
 
-	return locations
+	return input
 }
@@ -41,7 +41,7 @@ func TestEnhancedValidationDisabled(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Logf("Testing %q..", testCase.input)
 
-		supportedLocations = nil
+		defaultValidator.Store(nil)
 		warnings, errors := EnhancedValidate(testCase.input, "location")
 		valid := len(warnings) == 0 && len(errors) == 0
 		if testCase.valid != valid {
@@ -108,12 +108,12 @@ func TestEnhancedValidationEnabled(t *testing.T) {
 		},
 	}
 	defer func() {
-		supportedLocations = nil
+		defaultValidator.Store(nil)
 	}()
 
 	for _, testCase := range testCases {
 		t.Logf("Testing %q..", testCase.input)
-		supportedLocations = &testCase.availableLocations
+		defaultValidator.Store(NewLocationValidatorFromList(testCase.availableLocations))
 
 		warnings, errors := EnhancedValidate(testCase.input, "location")
 		valid := len(warnings) == 0 && len(errors) == 0
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// LocationValidator validates Locations against the set of Locations supported by a specific Azure
+// Environment, allowing multiple Environments to be used within the same process.
+//
+// When the set of supported Locations isn't available (for example when the user is offline) the
+// Location is validated against the embedded Catalogue for the Cloud instead. Since the embedded
+// Catalogue isn't authoritative (e.g. it won't contain newly released or Azure Stack Locations), a
+// warning rather than an error is returned for Locations which aren't in the Catalogue.
+type LocationValidator struct {
+	// locations is the canonical names of the supported Locations, which is nil when these aren't available
+	locations []string
+
+	// cloud is the Cloud whose embedded Catalogue is used when the supported Locations aren't available,
+	// when this is nil the embedded Catalogue for every Cloud is used
+	cloud *Cloud
+}
+
+// NewLocationValidatorFromMetaData returns a LocationValidator using the Locations returned from the Azure
+// MetaData Service for the specified Resource Manager Endpoint
+func NewLocationValidatorFromMetaData(ctx context.Context, resourceManagerEndpoint string) (*LocationValidator, error) {
+	locs, err := availableAzureLocations(ctx, resourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if locs.Locations == nil {
+		cloud, _ := CloudForResourceManagerEndpoint(resourceManagerEndpoint)
+		return &LocationValidator{
+			cloud: cloud,
+		}, nil
+	}
+
+	return NewLocationValidatorFromList(*locs.Locations), nil
+}
+
+// NewOfflineLocationValidator returns a LocationValidator which validates the Location against the
+// embedded Catalogue for the Cloud `cloud`, for use when the Azure MetaData Service isn't available
+func NewOfflineLocationValidator(cloud Cloud) *LocationValidator {
+	return &LocationValidator{
+		cloud: &cloud,
+	}
+}
+
+// NewLocationValidatorFromList returns a LocationValidator using the specified list of Locations, a nil
+// list means that the Location is validated against the embedded Catalogue for each Cloud
+func NewLocationValidatorFromList(locations []string) *LocationValidator {
+	if locations == nil {
+		return &LocationValidator{}
+	}
+
+	out := make([]string, 0, len(locations))
+	for _, v := range locations {
+		out = append(out, NormalizeCanonical(v))
+	}
+
+	return &LocationValidator{
+		locations: out,
+	}
+}
+
+type locationCacheFile struct {
+	Locations []string `json:"locations"`
+}
+
+// NewLocationValidatorFromCacheFile returns a LocationValidator using the Locations contained within
+// the cache file at `path`, which can be written using WriteCacheFile
+func NewLocationValidatorFromCacheFile(path string) (*LocationValidator, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading the location cache file %q: %+v", path, err)
+	}
+
+	var cache locationCacheFile
+	if err := json.Unmarshal(contents, &cache); err != nil {
+		return nil, fmt.Errorf("unmarshaling the location cache file %q: %+v", path, err)
+	}
+
+	return NewLocationValidatorFromList(cache.Locations), nil
+}
+
+// WriteCacheFile writes the Locations supported by this LocationValidator to the file at `path`, so
+// that these can be loaded using NewLocationValidatorFromCacheFile
+func (v *LocationValidator) WriteCacheFile(path string) error {
+	contents, err := json.Marshal(locationCacheFile{
+		Locations: v.locations,
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling the location cache file: %+v", err)
+	}
+
+	if err := os.WriteFile(path, contents, 0o600); err != nil {
+		return fmt.Errorf("writing the location cache file %q: %+v", path, err)
+	}
+
+	return nil
+}
+
+// Locations returns the canonical names of the Locations supported by this LocationValidator, which
+// is nil when the Location is validated against the embedded Catalogue instead
+func (v *LocationValidator) Locations() []string {
+	if v == nil || v.locations == nil {
+		return nil
+	}
+
+	return append([]string{}, v.locations...)
+}
+
+// ValidateFunc returns a SDKv2 validation function which validates the Location against the Locations
+// supported by this LocationValidator
+func (v *LocationValidator) ValidateFunc() schema.SchemaValidateFunc {
+	return v.validate
+}
+
+func (v *LocationValidator) validate(i interface{}, k string) ([]string, []error) {
+	if v == nil {
+		return offlineValidation(nil, i, k)
+	}
+	if v.locations == nil {
+		return offlineValidation(v.cloud, i, k)
+	}
+
+	input, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	normalizedUserInput := NormalizeCanonical(input)
+	if normalizedUserInput == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+
+	// Some resources use a location named "global".
+	if normalizedUserInput == "global" {
+		return nil, nil
+	}
+
+	for _, loc := range v.locations {
+		if normalizedUserInput == loc {
+			return nil, nil
+		}
+	}
+
+	return nil, []error{
+		fmt.Errorf("%q was not found in the list of supported Azure Locations: %q", normalizedUserInput, strings.Join(v.locations, ",")),
+	}
+}
+
+// offlineValidation validates the Location against the embedded Catalogue for the Cloud `cloud` (or each
+// Cloud when this is nil) - since the Catalogue isn't authoritative, a warning is returned for unknown Locations
+func offlineValidation(cloud *Cloud, i interface{}, k string) ([]string, []error) {
+	warnings, errs := validation.StringIsNotEmpty(i, k)
+	if len(errs) > 0 {
+		return warnings, errs
+	}
+
+	canonical, err := CanonicalName(i.(string))
+	if err != nil {
+		var unknown UnknownLocationError
+		if !errors.As(err, &unknown) {
+			return nil, []error{err}
+		}
+
+		return []string{
+			fmt.Sprintf("%q was not found in the offline catalogue of Azure Locations - this may be a new or Azure Stack Location, in which case this warning can be ignored", unknown.Normalized),
+		}, nil
+	}
+
+	// Some resources use a location named "global".
+	if cloud == nil || canonical == "global" {
+		return nil, nil
+	}
+
+	if _, ok := LookupRegion(*cloud, canonical); !ok {
+		return []string{
+			fmt.Sprintf("%q was not found in the offline catalogue of Azure Locations for the %q Cloud", canonical, string(*cloud)),
+		}, nil
+	}
+
+	return nil, nil
+}
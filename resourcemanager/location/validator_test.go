@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLocationValidatorIsolated(t *testing.T) {
+	public := NewLocationValidatorFromList([]string{"westeurope", "indiasouth"})
+	china := NewLocationValidatorFromList([]string{"chinanorth"})
+
+	testData := []struct {
+		validator *LocationValidator
+		input     string
+		valid     bool
+	}{
+		{
+			validator: public,
+			input:     "West Europe",
+			valid:     true,
+		},
+		{
+			validator: public,
+			input:     "South India",
+			valid:     true,
+		},
+		{
+			validator: public,
+			input:     "chinanorth",
+			valid:     false,
+		},
+		{
+			validator: china,
+			input:     "China North",
+			valid:     true,
+		},
+		{
+			validator: china,
+			input:     "westeurope",
+			valid:     false,
+		},
+		{
+			validator: china,
+			input:     "global",
+			valid:     true,
+		},
+		{
+			validator: china,
+			input:     "",
+			valid:     false,
+		},
+		{
+			// a nil list of locations falls back to the offline catalogue
+			validator: NewLocationValidatorFromList(nil),
+			input:     "westeurope",
+			valid:     true,
+		},
+		{
+			// the offline catalogue isn't authoritative, so unknown locations return a warning
+			validator: NewLocationValidatorFromList(nil),
+			input:     "atlantis",
+			valid:     false,
+		},
+		{
+			validator: NewLocationValidatorFromList(nil),
+			input:     "",
+			valid:     false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q against %+v", v.input, v.validator.Locations())
+
+		warnings, errors := v.validator.ValidateFunc()(v.input, "location")
+		valid := len(warnings) == 0 && len(errors) == 0
+		if v.valid != valid {
+			t.Fatalf("expected %t but got %t: %+v", v.valid, valid, errors)
+		}
+	}
+}
+
+func TestLocationValidatorCacheFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locations.json")
+
+	if err := NewLocationValidatorFromList([]string{"West Europe", "northeurope"}).WriteCacheFile(path); err != nil {
+		t.Fatalf("writing cache file: %+v", err)
+	}
+
+	validator, err := NewLocationValidatorFromCacheFile(path)
+	if err != nil {
+		t.Fatalf("reading cache file: %+v", err)
+	}
+	expected := []string{"westeurope", "northeurope"}
+	if !reflect.DeepEqual(expected, validator.Locations()) {
+		t.Fatalf("expected %+v but got %+v", expected, validator.Locations())
+	}
+
+	if _, err := NewLocationValidatorFromCacheFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatalf("expected an error for a missing cache file but didn't get one")
+	}
+}
@@ -167,6 +167,23 @@ func TestReCaserWithDataPlaneURI(t *testing.T) {
 	}
 }
 
+func TestReCaserWithRegisteredCommonIds(t *testing.T) {
+	testData := map[string]string{
+		"/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.operationalinsights/WORKSPACES/workspace1":                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/PRIVATEDNSZONES/privatelink.blob.core.windows.net": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.KeyVault/managedhsms/hsm1":                                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/managedHSMs/hsm1",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.extendedlocation/customlocations/location1":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ExtendedLocation/customLocations/location1",
+		"/PROVIDERS/microsoft.billing/BILLINGACCOUNTS/12345678/billingprofiles/profile1":                                                                          "/providers/Microsoft.Billing/billingAccounts/12345678/billingProfiles/profile1",
+		"/providers/microsoft.billing/billingaccounts/12345678":                                                                                                   "/providers/Microsoft.Billing/billingAccounts/12345678",
+	}
+
+	for input, expected := range testData {
+		if actual := ReCase(input); actual != expected {
+			t.Fatalf("Expected %q but got %q", expected, actual)
+		}
+	}
+}
+
 func getTestIds() map[string]resourceids.ResourceId {
 	return map[string]resourceids.ResourceId{
 		strings.ToLower(commonids.AppServiceId{}.ID()):        &commonids.AppServiceId{},
@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceids
+
+import (
+	"strings"
+)
+
+// NormalizeConstantValue returns the value from `possibleValues` which matches `input` case-insensitively, in
+// its canonical casing. When no match is found `input` is returned unmodified and the second value is false.
+func NormalizeConstantValue(input string, possibleValues []string) (string, bool) {
+	for _, v := range possibleValues {
+		if strings.EqualFold(v, input) {
+			return v, true
+		}
+	}
+
+	return input, false
+}
+
+// NormalizeConstantValue returns the canonically-cased value for `input` when this Segment is a Constant
+// Segment and `input` matches one of its Possible Values case-insensitively.
+func (s Segment) NormalizeConstantValue(input string) (string, bool) {
+	if s.Type != ConstantSegmentType || s.PossibleValues == nil {
+		return input, false
+	}
+
+	return NormalizeConstantValue(input, *s.PossibleValues)
+}
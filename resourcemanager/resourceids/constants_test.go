@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceids_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func TestNormalizeConstantValue(t *testing.T) {
+	possibleValues := []string{"Mars", "Earth"}
+	testData := []struct {
+		input    string
+		expected string
+		found    bool
+	}{
+		{
+			input:    "Earth",
+			expected: "Earth",
+			found:    true,
+		},
+		{
+			input:    "eArTh",
+			expected: "Earth",
+			found:    true,
+		},
+		{
+			input:    "MARS",
+			expected: "Mars",
+			found:    true,
+		},
+		{
+			input:    "Pluto",
+			expected: "Pluto",
+			found:    false,
+		},
+		{
+			input:    "",
+			expected: "",
+			found:    false,
+		},
+	}
+	for _, test := range testData {
+		t.Logf("Test %q..", test.input)
+		actual, found := resourceids.NormalizeConstantValue(test.input, possibleValues)
+		if actual != test.expected {
+			t.Fatalf("expected %q but got %q", test.expected, actual)
+		}
+		if found != test.found {
+			t.Fatalf("expected found to be %t but got %t", test.found, found)
+		}
+	}
+}
+
+func TestSegmentNormalizeConstantValue(t *testing.T) {
+	segment := resourceids.ConstantSegment("planetName", []string{"Mars", "Earth"}, "Mars")
+	if actual, ok := segment.NormalizeConstantValue("mars"); !ok || actual != "Mars" {
+		t.Fatalf("expected %q but got %q (found %t)", "Mars", actual, ok)
+	}
+
+	static := resourceids.StaticSegment("planets", "planets", "planets")
+	if actual, ok := static.NormalizeConstantValue("PLANETS"); ok || actual != "PLANETS" {
+		t.Fatalf("expected a Static Segment not to be normalised but got %q (found %t)", actual, ok)
+	}
+}
@@ -326,13 +326,14 @@ func (p Parser) parseSegment(segment Segment, rawValue string, insensitively boo
 			if segment.PossibleValues == nil {
 				return nil, fmt.Errorf("internal error: missing PossibleValues for Constant segment %q", segment.Name)
 			}
-			for _, possibleVal := range *segment.PossibleValues {
-				matches := possibleVal == rawValue
-				if insensitively {
-					matches = strings.EqualFold(possibleVal, rawValue)
+			if insensitively {
+				if v, ok := segment.NormalizeConstantValue(rawValue); ok {
+					return &v, nil
 				}
+			}
 
-				if matches {
+			for _, possibleVal := range *segment.PossibleValues {
+				if possibleVal == rawValue {
 					return &possibleVal, nil
 				}
 			}
@@ -594,6 +594,23 @@ func TestParseIdContainingAConstant(t *testing.T) {
 				RawInput: "/planets/earth",
 			},
 		},
+		{
+			name:        "planets - earth (wrong casing) - sensitive",
+			input:       "/planets/earth",
+			insensitive: false,
+		},
+		{
+			name:        "planets - earth (upper casing) - insensitive",
+			input:       "/planets/EARTH",
+			insensitive: true,
+			expected: &resourceids.ParseResult{
+				Parsed: map[string]string{
+					"planets":    "planets",
+					"planetName": "Earth",
+				},
+				RawInput: "/planets/EARTH",
+			},
+		},
 		{
 			name:        "planets - mars - sensitive",
 			input:       "/planets/Mars",
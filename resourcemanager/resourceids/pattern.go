@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceids
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Pattern is a compiled Resource ID pattern which can be evaluated against Resource IDs, for example
+// `/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/**`.
+//
+// Each segment of the pattern is matched against a single segment of the Resource ID, where:
+//
+//   - `*` matches any sequence of characters within a segment, e.g. `prod-*`
+//   - `?` matches any single character within a segment
+//   - `**` as an entire segment matches zero or more segments
+//
+// Since Azure Resource Manager treats Resource IDs case-insensitively, all segments are matched
+// case-insensitively.
+type Pattern struct {
+	raw      string
+	segments []patternSegment
+}
+
+type patternSegment struct {
+	// value is the lower-cased value for this segment
+	value string
+
+	// anyDepth specifies that this segment is `**` and so matches zero or more segments
+	anyDepth bool
+
+	// literal specifies that this segment contains no wildcards
+	literal bool
+}
+
+// CompilePattern parses `pattern` into a Pattern which can be used to match Resource IDs
+func CompilePattern(pattern string) (*Pattern, error) {
+	if !strings.HasPrefix(pattern, "/") {
+		return nil, fmt.Errorf("expected the pattern %q to start with a `/`", pattern)
+	}
+
+	out := Pattern{
+		raw:      pattern,
+		segments: make([]patternSegment, 0),
+	}
+
+	trimmed := strings.TrimPrefix(pattern, "/")
+	if trimmed == "" {
+		return &out, nil
+	}
+
+	for i, segment := range strings.Split(trimmed, "/") {
+		if segment == "" {
+			return nil, fmt.Errorf("segment %d of the pattern %q was empty", i, pattern)
+		}
+
+		if segment == "**" {
+			// consecutive `**` segments are equivalent to a single one
+			if l := len(out.segments); l > 0 && out.segments[l-1].anyDepth {
+				continue
+			}
+
+			out.segments = append(out.segments, patternSegment{
+				anyDepth: true,
+			})
+			continue
+		}
+
+		if strings.Contains(segment, "**") {
+			return nil, fmt.Errorf("segment %d of the pattern %q contains `**` which is only supported as an entire segment", i, pattern)
+		}
+
+		out.segments = append(out.segments, patternSegment{
+			value:   strings.ToLower(segment),
+			literal: !strings.ContainsAny(segment, "*?"),
+		})
+	}
+
+	return &out, nil
+}
+
+// MustCompilePattern is like CompilePattern but panics if the pattern cannot be parsed
+func MustCompilePattern(pattern string) Pattern {
+	out, err := CompilePattern(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("compiling pattern %q: %+v", pattern, err))
+	}
+
+	return *out
+}
+
+// String returns the pattern this Pattern was compiled from
+func (p Pattern) String() string {
+	return p.raw
+}
+
+// Match reports whether the Resource ID `id` matches this Pattern
+func (p Pattern) Match(id ResourceId) bool {
+	return p.MatchString(id.ID())
+}
+
+// MatchString reports whether the Resource ID `input` matches this Pattern
+func (p Pattern) MatchString(input string) bool {
+	segments, ok := splitResourceIdForPattern(input)
+	if !ok {
+		return false
+	}
+
+	return p.matchSegments(segments, false)
+}
+
+// MatchWithinScope reports whether the Resource ID `id` either matches this Pattern, or is nested
+// beneath a Scope which matches this Pattern
+func (p Pattern) MatchWithinScope(id ResourceId) bool {
+	return p.MatchStringWithinScope(id.ID())
+}
+
+// MatchStringWithinScope reports whether the Resource ID `input` either matches this Pattern, or is
+// nested beneath a Scope which matches this Pattern - for example the pattern `/subscriptions/*` will
+// match every Resource ID within any Subscription.
+func (p Pattern) MatchStringWithinScope(input string) bool {
+	segments, ok := splitResourceIdForPattern(input)
+	if !ok {
+		return false
+	}
+
+	return p.matchSegments(segments, true)
+}
+
+// matchSegments determines whether `input` matches the segments of this Pattern, when `allowRemainder` is
+// true any segments in `input` which remain once the Pattern has been matched are ignored.
+func (p Pattern) matchSegments(input []string, allowRemainder bool) bool {
+	// matches[j] tracks whether the pattern segments processed so far match the first `j` input segments
+	matches := make([]bool, len(input)+1)
+	next := make([]bool, len(input)+1)
+	matches[0] = true
+
+	for _, segment := range p.segments {
+		next[0] = false
+		if segment.anyDepth {
+			// `**` matches zero or more segments, so once a prefix matches so does every longer prefix
+			for j := range next {
+				next[j] = matches[j] || (j > 0 && next[j-1])
+			}
+		} else {
+			for j := 1; j <= len(input); j++ {
+				next[j] = matches[j-1] && segment.matches(input[j-1])
+			}
+		}
+		matches, next = next, matches
+	}
+
+	if !allowRemainder {
+		return matches[len(input)]
+	}
+
+	for _, v := range matches {
+		if v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s patternSegment) matches(input string) bool {
+	if s.literal {
+		return strings.EqualFold(s.value, input)
+	}
+
+	return globMatch(s.value, strings.ToLower(input))
+}
+
+// globMatch reports whether `input` matches `pattern` where `*` matches any sequence of characters
+// and `?` matches any single character
+func globMatch(patternValue, inputValue string) bool {
+	pattern := []rune(patternValue)
+	input := []rune(inputValue)
+
+	p, i := 0, 0
+	starIdx, matchIdx := -1, 0
+	for i < len(input) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == input[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			starIdx = p
+			matchIdx = i
+			p++
+		case starIdx != -1:
+			p = starIdx + 1
+			matchIdx++
+			i = matchIdx
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// splitResourceIdForPattern splits the Resource ID `input` into its segments
+func splitResourceIdForPattern(input string) ([]string, bool) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, false
+	}
+
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(input, "/"), "/")
+	if trimmed == "" {
+		return []string{}, true
+	}
+
+	segments := strings.Split(trimmed, "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, false
+		}
+	}
+
+	return segments, true
+}
@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceids_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func TestCompilePattern(t *testing.T) {
+	testData := []struct {
+		pattern     string
+		expectError bool
+	}{
+		{
+			pattern:     "",
+			expectError: true,
+		},
+		{
+			pattern:     "subscriptions/*",
+			expectError: true,
+		},
+		{
+			pattern:     "/subscriptions//resourceGroups",
+			expectError: true,
+		},
+		{
+			pattern:     "/subscriptions/a**",
+			expectError: true,
+		},
+		{
+			pattern: "/",
+		},
+		{
+			pattern: "/**",
+		},
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/**",
+		},
+		{
+			pattern: "/subscriptions/**/**/virtualMachines/vm?",
+		},
+	}
+	for _, test := range testData {
+		t.Logf("Test %q..", test.pattern)
+		actual, err := resourceids.CompilePattern(test.pattern)
+		if test.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual.String() != test.pattern {
+			t.Fatalf("expected %q but got %q", test.pattern, actual.String())
+		}
+	}
+}
+
+func TestPatternMatchString(t *testing.T) {
+	testData := []struct {
+		pattern string
+		input   string
+		match   bool
+	}{
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/*",
+			input:   "/subscriptions/11111/resourceGroups/prod-west/providers/Microsoft.Network/virtualNetworks",
+			match:   true,
+		},
+		{
+			// wildcards don't span segments
+			pattern: "/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/*",
+			input:   "/subscriptions/11111/resourceGroups/prod-west/providers/Microsoft.Network/virtualNetworks/network1",
+			match:   false,
+		},
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/**",
+			input:   "/subscriptions/11111/resourceGroups/prod-west/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			match:   true,
+		},
+		{
+			// `**` matches zero segments
+			pattern: "/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/**",
+			input:   "/subscriptions/11111/resourceGroups/prod-west/providers/Microsoft.Network",
+			match:   true,
+		},
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/**",
+			input:   "/subscriptions/11111/resourceGroups/dev-west/providers/Microsoft.Network/virtualNetworks/network1",
+			match:   false,
+		},
+		{
+			// static segments are case-insensitive
+			pattern: "/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/**",
+			input:   "/SUBSCRIPTIONS/11111/resourcegroups/PROD-west/providers/microsoft.network/virtualNetworks/network1",
+			match:   true,
+		},
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*/providers/Microsoft.Network/**",
+			input:   "/subscriptions/11111/resourceGroups/prod-west/providers/Microsoft.Compute/virtualMachines/vm1",
+			match:   false,
+		},
+		{
+			pattern: "/**/providers/Microsoft.Compute/virtualMachines/vm?",
+			input:   "/subscriptions/11111/resourceGroups/prod-west/providers/Microsoft.Compute/virtualMachines/vm1",
+			match:   true,
+		},
+		{
+			pattern: "/**/providers/Microsoft.Compute/virtualMachines/vm?",
+			input:   "/subscriptions/11111/resourceGroups/prod-west/providers/Microsoft.Compute/virtualMachines/vm10",
+			match:   false,
+		},
+		{
+			pattern: "/subscriptions/*/**/subnets/*",
+			input:   "/subscriptions/11111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			match:   true,
+		},
+		{
+			pattern: "/subscriptions/*",
+			input:   "/subscriptions/11111/resourceGroups/group1",
+			match:   false,
+		},
+		{
+			pattern: "/",
+			input:   "/",
+			match:   true,
+		},
+		{
+			pattern: "/**",
+			input:   "/subscriptions/11111",
+			match:   true,
+		},
+		{
+			pattern: "/subscriptions/*",
+			input:   "subscriptions/11111",
+			match:   false,
+		},
+		{
+			pattern: "/subscriptions/*",
+			input:   "",
+			match:   false,
+		},
+	}
+	for _, test := range testData {
+		t.Logf("Test %q against %q..", test.input, test.pattern)
+		pattern := resourceids.MustCompilePattern(test.pattern)
+		if actual := pattern.MatchString(test.input); actual != test.match {
+			t.Fatalf("expected %t but got %t", test.match, actual)
+		}
+	}
+}
+
+func TestPatternMatchStringWithinScope(t *testing.T) {
+	testData := []struct {
+		pattern string
+		input   string
+		match   bool
+	}{
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*",
+			input:   "/subscriptions/11111/resourceGroups/prod-west",
+			match:   true,
+		},
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*",
+			input:   "/subscriptions/11111/resourceGroups/prod-west/providers/Microsoft.Compute/virtualMachines/vm1",
+			match:   true,
+		},
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*",
+			input:   "/subscriptions/11111/resourceGroups/dev-west/providers/Microsoft.Compute/virtualMachines/vm1",
+			match:   false,
+		},
+		{
+			// a scope must match entire segments
+			pattern: "/subscriptions/*/resourceGroups/prod",
+			input:   "/subscriptions/11111/resourceGroups/production/providers/Microsoft.Compute/virtualMachines/vm1",
+			match:   false,
+		},
+		{
+			pattern: "/subscriptions/*/resourceGroups/prod-*",
+			input:   "/subscriptions/11111",
+			match:   false,
+		},
+		{
+			pattern: "/",
+			input:   "/subscriptions/11111",
+			match:   true,
+		},
+	}
+	for _, test := range testData {
+		t.Logf("Test %q against %q..", test.input, test.pattern)
+		pattern := resourceids.MustCompilePattern(test.pattern)
+		if actual := pattern.MatchStringWithinScope(test.input); actual != test.match {
+			t.Fatalf("expected %t but got %t", test.match, actual)
+		}
+	}
+}
+
+func TestPatternMatch(t *testing.T) {
+	id := patternTestId{
+		id: "/subscriptions/11111",
+	}
+
+	if !resourceids.MustCompilePattern("/subscriptions/1*").Match(id) {
+		t.Fatalf("expected %q to match", id.ID())
+	}
+
+	if resourceids.MustCompilePattern("/subscriptions/2*").Match(id) {
+		t.Fatalf("expected %q not to match", id.ID())
+	}
+
+	if !resourceids.MustCompilePattern("/subscriptions").MatchWithinScope(id) {
+		t.Fatalf("expected %q to match within scope", id.ID())
+	}
+}
+
+func TestMustCompilePatternPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected a panic but didn't get one")
+		}
+	}()
+
+	resourceids.MustCompilePattern("invalid")
+}
+
+var _ resourceids.ResourceId = patternTestId{}
+
+type patternTestId struct {
+	id string
+}
+
+func (p patternTestId) ID() string {
+	return p.id
+}
+
+func (p patternTestId) String() string {
+	return p.id
+}
+
+func (p patternTestId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", p.id),
+	}
+}
+
+func (p patternTestId) FromParseResult(resourceids.ParseResult) error {
+	panic("shouldn't be called in test")
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DiffSuppressFunc suppresses the diff for Tags when the old and new values only differ by the casing
+// of the Tag keys - since Azure treats Tag keys case-insensitively, but returns the casing of the key
+// that was first used, which may not match the casing in the configuration.
+//
+// NOTE: this is called for each element of the Map (e.g. `tags.Environment` and `tags.%`), so the entire
+// Map is compared rather than the individual element.
+func DiffSuppressFunc(k, _, _ string, d *schema.ResourceData) bool {
+	oldRaw, newRaw, ok := getMapChange(k, d)
+	if !ok {
+		return false
+	}
+
+	oldTags, err := expandTagsMap(oldRaw)
+	if err != nil {
+		return false
+	}
+	newTags, err := expandTagsMap(newRaw)
+	if err != nil {
+		return false
+	}
+
+	return EqualIgnoringKeyCasing(oldTags, newTags)
+}
+
+// getMapChange returns the old and new values for the Map containing the element `k`. Since Tag keys can
+// contain a `.`, the key for the Map is the first prefix of `k` whose value contains the remainder of `k`.
+func getMapChange(k string, d *schema.ResourceData) (interface{}, interface{}, bool) {
+	for idx := 0; idx < len(k); idx++ {
+		if k[idx] != '.' {
+			continue
+		}
+		key, element := k[:idx], k[idx+1:]
+
+		oldRaw, newRaw := d.GetChange(key)
+		oldMap, oldOk := oldRaw.(map[string]interface{})
+		newMap, newOk := newRaw.(map[string]interface{})
+		if !oldOk || !newOk {
+			continue
+		}
+
+		_, inOld := oldMap[element]
+		_, inNew := newMap[element]
+		if element == "%" || inOld || inNew {
+			return oldMap, newMap, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+// EqualIgnoringKeyCasing returns whether the Tags `a` and `b` contain the same keys (compared case-insensitively)
+// with the same values
+func EqualIgnoringKeyCasing(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		key, ok := findKey(b, k)
+		if !ok || b[key] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NormalizeKeyCasing reconciles the casing of the Tag keys returned from the API (`input`) with the casing
+// of the Tag keys specified in the configuration (`configured`), such that the configured casing is used
+// for any keys which only differ by casing.
+func NormalizeKeyCasing(configured map[string]string, input map[string]string) map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range input {
+		if configuredKey, ok := findKey(configured, k); ok {
+			output[configuredKey] = v
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// FlattenAndSetWithConfiguredCasing first Flatten's the Tags, using the casing of the keys present in the
+// `tags` field for any keys which only differ by casing, and then sets the flattened value into the `tags`
+// field in the State.
+func FlattenAndSetWithConfiguredCasing(d *schema.ResourceData, input *map[string]string) error {
+	if input != nil {
+		existing, _ := d.Get("tags").(map[string]interface{})
+		normalized := NormalizeKeyCasing(*Expand(existing), *input)
+		input = &normalized
+	}
+
+	return FlattenAndSet(d, input)
+}
+
+// ValidateUniqueKeysCaseInsensitive validates that the Tags don't contain multiple keys which only differ by
+// casing, since Azure treats Tag keys case-insensitively
+func ValidateUniqueKeysCaseInsensitive(i interface{}, k string) (warnings []string, errors []error) {
+	tagsMap, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be map", k))
+		return warnings, errors
+	}
+
+	for _, keys := range caseInsensitiveDuplicateKeys(tagsMap) {
+		errors = append(errors, fmt.Errorf("the tag keys %q in %s must be unique, but only differ by casing", keys, k))
+	}
+
+	return warnings, errors
+}
+
+// CaseInsensitiveDuplicateKeys returns each group of keys within `input` which only differ by casing
+func CaseInsensitiveDuplicateKeys(input map[string]string) [][]string {
+	keys := make(map[string]interface{}, len(input))
+	for k := range input {
+		keys[k] = nil
+	}
+
+	return caseInsensitiveDuplicateKeys(keys)
+}
+
+func caseInsensitiveDuplicateKeys(input map[string]interface{}) [][]string {
+	grouped := make(map[string][]string)
+	for k := range input {
+		lower := strings.ToLower(k)
+		grouped[lower] = append(grouped[lower], k)
+	}
+
+	output := make([][]string, 0)
+	for _, keys := range grouped {
+		if len(keys) > 1 {
+			sort.Strings(keys)
+			output = append(output, keys)
+		}
+	}
+	sort.Slice(output, func(i, j int) bool {
+		return output[i][0] < output[j][0]
+	})
+
+	return output
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDiffSuppressFunc(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				DiffSuppressFunc: DiffSuppressFunc,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		State    map[string]string
+		Config   map[string]interface{}
+		Expected bool
+	}{
+		{
+			Name: "same casing",
+			State: map[string]string{
+				"environment": "prod",
+			},
+			Config: map[string]interface{}{
+				"environment": "prod",
+			},
+			Expected: false,
+		},
+		{
+			Name: "different key casing",
+			State: map[string]string{
+				"environment": "prod",
+				"cost.centre": "123",
+			},
+			Config: map[string]interface{}{
+				"Environment": "prod",
+				"Cost.Centre": "123",
+			},
+			Expected: false,
+		},
+		{
+			Name: "different value casing",
+			State: map[string]string{
+				"environment": "prod",
+			},
+			Config: map[string]interface{}{
+				"Environment": "Prod",
+			},
+			Expected: true,
+		},
+		{
+			Name: "additional key",
+			State: map[string]string{
+				"environment": "prod",
+			},
+			Config: map[string]interface{}{
+				"Environment": "prod",
+				"team":        "networking",
+			},
+			Expected: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		state := &terraform.InstanceState{
+			ID: "example",
+			Attributes: map[string]string{
+				"id":     "example",
+				"tags.%": strconv.Itoa(len(v.State)),
+			},
+		}
+		for k, val := range v.State {
+			state.Attributes["tags."+k] = val
+		}
+
+		diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"tags": v.Config,
+		}), nil)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+
+		hasDiff := diff != nil && len(diff.Attributes) > 0
+		if hasDiff != v.Expected {
+			t.Fatalf("expected a diff to be %t but got %+v", v.Expected, diff)
+		}
+	}
+}
+
+func TestNormalizeKeyCasing(t *testing.T) {
+	configured := map[string]string{
+		"Environment": "prod",
+		"Team":        "networking",
+	}
+	input := map[string]string{
+		"environment": "prod",
+		"TEAM":        "networking",
+		"owner":       "platform",
+	}
+
+	expected := map[string]string{
+		"Environment": "prod",
+		"Team":        "networking",
+		"owner":       "platform",
+	}
+	actual := NormalizeKeyCasing(configured, input)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestValidateUniqueKeysCaseInsensitive(t *testing.T) {
+	_, errs := ValidateUniqueKeysCaseInsensitive(map[string]interface{}{
+		"environment": "prod",
+		"team":        "networking",
+	}, "tags")
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors but got %+v", errs)
+	}
+
+	_, errs = ValidateUniqueKeysCaseInsensitive(map[string]interface{}{
+		"environment": "prod",
+		"Environment": "prod",
+		"team":        "networking",
+		"TEAM":        "networking",
+		"Team":        "networking",
+	}, "tags")
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors but got %d: %+v", len(errs), errs)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Merge combines the default Tags (typically configured at the Provider level) with the Tags specified
+// on the Resource, where the values from the Resource take precedence.
+//
+// Tag keys are case-insensitive within Azure, as such a default Tag is omitted when a Tag with the same
+// key (in any casing) is specified on the Resource.
+func Merge(defaults map[string]string, input map[string]string) map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range defaults {
+		if _, ok := findKey(input, k); ok {
+			continue
+		}
+		output[k] = v
+	}
+
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
+
+// ExpandWithDefaults transforms the input Tags to a `*map[string]string`, merging in the default Tags
+func ExpandWithDefaults(defaults map[string]string, input map[string]interface{}) *map[string]string {
+	output := Merge(defaults, *Expand(input))
+	return &output
+}
+
+// RemoveDefaults removes the default Tags from the Tags returned from the API (`input`), so that these
+// aren't surfaced in the `tags` field. A default Tag is retained when its value has been overridden,
+// or when it's been explicitly specified within the configuration (`configured`).
+func RemoveDefaults(defaults map[string]string, configured map[string]string, input map[string]string) map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range input {
+		if _, ok := findKey(configured, k); !ok {
+			if defaultKey, ok := findKey(defaults, k); ok && defaults[defaultKey] == v {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// FlattenWithoutDefaults transforms the Tags specified via `input` into a map[string]interface{} for
+// compatibility with the Schema, removing any default Tags which haven't been specified in the `configured` Tags.
+func FlattenWithoutDefaults(defaults map[string]string, configured map[string]interface{}, input *map[string]string) map[string]interface{} {
+	if input == nil {
+		return Flatten(nil)
+	}
+
+	output := RemoveDefaults(defaults, *Expand(configured), *input)
+	return Flatten(&output)
+}
+
+// TagsAllCustomizeDiff returns a CustomizeDiffFunc which computes the value for the field `tags_all` by
+// merging the default Tags (returned from `defaults` using the Provider meta) with the Tags in the field `tags`.
+func TagsAllCustomizeDiff(defaults func(meta interface{}) map[string]string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("tags") {
+			if err := d.SetNewComputed("tags_all"); err != nil {
+				return fmt.Errorf("setting `tags_all` to computed: %+v", err)
+			}
+			return nil
+		}
+
+		var defaultTags map[string]string
+		if defaults != nil {
+			defaultTags = defaults(meta)
+		}
+
+		raw, _ := d.Get("tags").(map[string]interface{})
+		merged := Flatten(ExpandWithDefaults(defaultTags, raw))
+
+		existing, _ := d.Get("tags_all").(map[string]interface{})
+		if reflect.DeepEqual(existing, merged) {
+			return nil
+		}
+
+		if err := d.SetNew("tags_all", merged); err != nil {
+			return fmt.Errorf("setting `tags_all`: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func findKey(input map[string]string, key string) (string, bool) {
+	if _, ok := input[key]; ok {
+		return key, true
+	}
+
+	for k := range input {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+
+	return "", false
+}
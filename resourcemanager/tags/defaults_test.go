@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMerge(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Defaults map[string]string
+		Input    map[string]string
+		Expected map[string]string
+	}{
+		{
+			Name:     "no tags",
+			Defaults: nil,
+			Input:    nil,
+			Expected: map[string]string{},
+		},
+		{
+			Name: "defaults only",
+			Defaults: map[string]string{
+				"environment": "prod",
+			},
+			Input: nil,
+			Expected: map[string]string{
+				"environment": "prod",
+			},
+		},
+		{
+			Name: "resource values win",
+			Defaults: map[string]string{
+				"environment": "prod",
+				"owner":       "platform",
+			},
+			Input: map[string]string{
+				"environment": "dev",
+				"team":        "networking",
+			},
+			Expected: map[string]string{
+				"environment": "dev",
+				"owner":       "platform",
+				"team":        "networking",
+			},
+		},
+		{
+			Name: "keys are case-insensitive",
+			Defaults: map[string]string{
+				"Environment": "prod",
+			},
+			Input: map[string]string{
+				"environment": "dev",
+			},
+			Expected: map[string]string{
+				"environment": "dev",
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := Merge(v.Defaults, v.Input)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenWithoutDefaults(t *testing.T) {
+	defaults := map[string]string{
+		"environment": "prod",
+		"owner":       "platform",
+		"cost-centre": "123",
+	}
+	configured := map[string]interface{}{
+		"cost-centre": "123",
+		"team":        "networking",
+	}
+	input := map[string]string{
+		// overridden outside of Terraform, so this is retained
+		"Environment": "dev",
+		"owner":       "platform",
+		"cost-centre": "123",
+		"team":        "networking",
+	}
+
+	expected := map[string]interface{}{
+		"Environment": "dev",
+		"cost-centre": "123",
+		"team":        "networking",
+	}
+	actual := FlattenWithoutDefaults(defaults, configured, &input)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := FlattenWithoutDefaults(defaults, configured, nil); len(actual) != 0 {
+		t.Fatalf("expected no tags but got %+v", actual)
+	}
+}
+
+func TestTagsAllCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		CustomizeDiff: TagsAllCustomizeDiff(func(meta interface{}) map[string]string {
+			return meta.(map[string]string)
+		}),
+	}
+
+	defaults := map[string]string{
+		"environment": "prod",
+		"owner":       "platform",
+	}
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "dev",
+		},
+	})
+	diff, err := resource.Diff(context.Background(), nil, cfg, defaults)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+
+	expected := map[string]string{
+		"tags_all.%":           "2",
+		"tags_all.environment": "dev",
+		"tags_all.owner":       "platform",
+	}
+	for k, v := range expected {
+		attr, ok := diff.Attributes[k]
+		if !ok {
+			t.Fatalf("expected a diff for %q but didn't get one", k)
+		}
+		if attr.New != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, attr.New)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DefaultForbiddenKeyCharacters is the set of characters which Azure doesn't allow within a Tag key
+const DefaultForbiddenKeyCharacters = `<>%&\?/`
+
+// DefaultReservedPrefixes is the set of prefixes which are reserved by Azure and can't be used for a Tag key
+var DefaultReservedPrefixes = []string{
+	"microsoft",
+	"azure",
+	"windows",
+}
+
+// Policy describes the rules which a set of Tags must conform to.
+//
+// Tag keys are case-insensitive within Azure, as such Required Keys, Value Patterns and Reserved Prefixes
+// are all compared case-insensitively.
+type Policy struct {
+	// RequiredKeys is a list of Tag keys which must be specified
+	RequiredKeys []string
+
+	// ValuePatterns is a map of Tag key to a regular expression which the value for that Tag must match
+	ValuePatterns map[string]*regexp.Regexp
+
+	// ForbiddenKeyCharacters is a string containing the characters which can't be used within a Tag key
+	ForbiddenKeyCharacters string
+
+	// ReservedPrefixes is a list of prefixes which can't be used for a Tag key
+	ReservedPrefixes []string
+}
+
+// DefaultPolicy returns a Policy containing the restrictions Azure applies to Tag keys, which can be
+// extended with Required Keys and Value Patterns
+func DefaultPolicy() Policy {
+	return Policy{
+		ValuePatterns:          map[string]*regexp.Regexp{},
+		ForbiddenKeyCharacters: DefaultForbiddenKeyCharacters,
+		ReservedPrefixes:       append([]string{}, DefaultReservedPrefixes...),
+	}
+}
+
+// PolicyViolation describes a Tag which doesn't conform to a Policy. Key is empty when the
+// violation applies to the Tags as a whole.
+type PolicyViolation struct {
+	Key     string
+	Summary string
+	Detail  string
+}
+
+func (v PolicyViolation) Error() string {
+	if v.Key == "" {
+		return fmt.Sprintf("%s: %s", v.Summary, v.Detail)
+	}
+	return fmt.Sprintf("%s for the tag %q: %s", v.Summary, v.Key, v.Detail)
+}
+
+// Evaluate returns the violations of this Policy by the specified Tags, sorted by key
+func (p Policy) Evaluate(input map[string]string) []PolicyViolation {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+
+	return p.EvaluatePartial(keys, input)
+}
+
+// EvaluatePartial returns the violations of this Policy by the Tags with the specified `keys`, sorted by key.
+// This allows Tags whose values aren't yet known to be validated - `values` only needs to contain the known values.
+func (p Policy) EvaluatePartial(keys []string, values map[string]string) []PolicyViolation {
+	keys = append([]string{}, keys...)
+	sort.Strings(keys)
+	out := make([]PolicyViolation, 0)
+
+	for _, required := range p.RequiredKeys {
+		found := false
+		for _, key := range keys {
+			if strings.EqualFold(key, required) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, PolicyViolation{
+				Summary: "Missing Required Tag",
+				Detail:  fmt.Sprintf("the tag %q must be specified", required),
+			})
+		}
+	}
+
+	for _, key := range keys {
+		if p.ForbiddenKeyCharacters != "" && strings.ContainsAny(key, p.ForbiddenKeyCharacters) {
+			out = append(out, PolicyViolation{
+				Key:     key,
+				Summary: "Invalid Tag Key",
+				Detail:  fmt.Sprintf("tag keys cannot contain any of the characters %q", p.ForbiddenKeyCharacters),
+			})
+		}
+
+		for _, prefix := range p.ReservedPrefixes {
+			if strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+				out = append(out, PolicyViolation{
+					Key:     key,
+					Summary: "Reserved Tag Key",
+					Detail:  fmt.Sprintf("tag keys cannot start with the reserved prefix %q", prefix),
+				})
+			}
+		}
+
+		value, known := values[key]
+		if !known {
+			continue
+		}
+		for patternKey, pattern := range p.ValuePatterns {
+			if pattern == nil || !strings.EqualFold(key, patternKey) {
+				continue
+			}
+			if !pattern.MatchString(value) {
+				out = append(out, PolicyViolation{
+					Key:     key,
+					Summary: "Invalid Tag Value",
+					Detail:  fmt.Sprintf("the value %q must match the regular expression %q", value, pattern.String()),
+				})
+			}
+		}
+	}
+
+	return out
+}
+
+// ValidateFunc returns a SDKv2 validation function which validates the Tags against this Policy.
+//
+// NOTE: SDKv2 doesn't call validation functions when the `tags` field is omitted, as such CustomizeDiff
+// must also be used to enforce the Required Keys.
+// nolint: staticcheck
+func (p Policy) ValidateFunc() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errs []error) {
+		input, err := expandTagsMap(i)
+		if err != nil {
+			return nil, []error{fmt.Errorf("%s: %+v", k, err)}
+		}
+
+		for _, v := range p.Evaluate(input) {
+			errs = append(errs, fmt.Errorf("%s: %+v", k, v))
+		}
+		return warnings, errs
+	}
+}
+
+// ValidateDiagFunc returns a SDKv2 validation function which validates the Tags against this Policy,
+// returning a diagnostic for each violation scoped to the Tag key where possible.
+//
+// NOTE: SDKv2 doesn't call validation functions when the `tags` field is omitted, as such CustomizeDiff
+// must also be used to enforce the Required Keys.
+func (p Policy) ValidateDiagFunc() schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) (diags diag.Diagnostics) {
+		input, err := expandTagsMap(i)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, v := range p.Evaluate(input) {
+			attributePath := path
+			if v.Key != "" {
+				attributePath = path.Index(cty.StringVal(v.Key))
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       v.Summary,
+				Detail:        v.Detail,
+				AttributePath: attributePath,
+			})
+		}
+		return diags
+	}
+}
+
+// CustomizeDiff returns a CustomizeDiffFunc which enforces the Required Keys of this Policy for the Tags in
+// the field `tagsKey`, including when the field is omitted. The remaining rules are validated by ValidateFunc
+// or ValidateDiagFunc, which aren't called for an omitted field. Validation is skipped when the Tags aren't yet known.
+func (p Policy) CustomizeDiff(tagsKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if len(p.RequiredKeys) == 0 || !d.NewValueKnown(tagsKey) {
+			return nil
+		}
+
+		raw, _ := d.Get(tagsKey).(map[string]interface{})
+		keys := make([]string, 0, len(raw))
+		for key := range raw {
+			keys = append(keys, key)
+		}
+
+		required := Policy{
+			RequiredKeys: p.RequiredKeys,
+		}
+		errs := make([]error, 0)
+		for _, v := range required.EvaluatePartial(keys, nil) {
+			errs = append(errs, fmt.Errorf("%s: %+v", tagsKey, v))
+		}
+		return errors.Join(errs...)
+	}
+}
+
+func expandTagsMap(i interface{}) (map[string]string, error) {
+	raw, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a map but got %T", i)
+	}
+
+	out := make(map[string]string, len(raw))
+	errs := make([]error, 0)
+	for key, v := range raw {
+		value, err := tagValueToString(v)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		out[key] = value
+	}
+
+	return out, errors.Join(errs...)
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testPolicy() Policy {
+	policy := DefaultPolicy()
+	policy.RequiredKeys = []string{"environment"}
+	policy.ValuePatterns["environment"] = regexp.MustCompile(`^(dev|test|prod)$`)
+	return policy
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    map[string]string
+		Expected []PolicyViolation
+	}{
+		{
+			Name: "valid",
+			Input: map[string]string{
+				"environment": "prod",
+				"team":        "networking",
+			},
+			Expected: []PolicyViolation{},
+		},
+		{
+			Name: "required keys are case-insensitive",
+			Input: map[string]string{
+				"Environment": "dev",
+			},
+			Expected: []PolicyViolation{},
+		},
+		{
+			Name:  "missing required key",
+			Input: map[string]string{},
+			Expected: []PolicyViolation{
+				{Summary: "Missing Required Tag"},
+			},
+		},
+		{
+			Name: "invalid value",
+			Input: map[string]string{
+				"ENVIRONMENT": "staging",
+			},
+			Expected: []PolicyViolation{
+				{Key: "ENVIRONMENT", Summary: "Invalid Tag Value"},
+			},
+		},
+		{
+			Name: "forbidden characters and reserved prefixes",
+			Input: map[string]string{
+				"environment":   "test",
+				"cost/centre":   "123",
+				"AzureService":  "example",
+				"windows<name>": "example",
+			},
+			Expected: []PolicyViolation{
+				{Key: "AzureService", Summary: "Reserved Tag Key"},
+				{Key: "cost/centre", Summary: "Invalid Tag Key"},
+				{Key: "windows<name>", Summary: "Invalid Tag Key"},
+				{Key: "windows<name>", Summary: "Reserved Tag Key"},
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := testPolicy().Evaluate(v.Input)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("expected %d violations but got %d: %+v", len(v.Expected), len(actual), actual)
+		}
+		for i, expected := range v.Expected {
+			if actual[i].Key != expected.Key || actual[i].Summary != expected.Summary {
+				t.Fatalf("expected violation %d to be %q for %q but got %q for %q", i, expected.Summary, expected.Key, actual[i].Summary, actual[i].Key)
+			}
+		}
+	}
+}
+
+func TestPolicyEvaluatePartial(t *testing.T) {
+	// the value for `environment` isn't known, so only the keys can be validated
+	actual := testPolicy().EvaluatePartial([]string{"environment", "microsoft.owner"}, map[string]string{})
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 violation but got %d: %+v", len(actual), actual)
+	}
+	if actual[0].Key != "microsoft.owner" || actual[0].Summary != "Reserved Tag Key" {
+		t.Fatalf("expected a reserved prefix violation for `microsoft.owner` but got %+v", actual[0])
+	}
+}
+
+func TestPolicyValidateFunc(t *testing.T) {
+	_, errs := testPolicy().ValidateFunc()(map[string]interface{}{
+		"environment": "prod",
+	}, "tags")
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors but got %+v", errs)
+	}
+
+	_, errs = testPolicy().ValidateFunc()(map[string]interface{}{
+		"environment": "staging",
+		"azure-owner": "example",
+	}, "tags")
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors but got %d: %+v", len(errs), errs)
+	}
+}
+
+func TestPolicyValidateDiagFunc(t *testing.T) {
+	path := cty.GetAttrPath("tags")
+	diags := testPolicy().ValidateDiagFunc()(map[string]interface{}{
+		"environment": "staging",
+	}, path)
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic but got %d: %+v", len(diags), diags)
+	}
+
+	expected := path.Index(cty.StringVal("environment"))
+	if !diags[0].AttributePath.Equals(expected) {
+		t.Fatalf("expected the diagnostic to be scoped to %#v but got %#v", expected, diags[0].AttributePath)
+	}
+}
+
+func TestPolicyCustomizeDiff(t *testing.T) {
+	policy := testPolicy()
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: policy.ValidateFunc(),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		CustomizeDiff: policy.CustomizeDiff("tags"),
+	}
+
+	testData := []struct {
+		name   string
+		config map[string]interface{}
+		valid  bool
+	}{
+		{
+			name:   "omitted tags",
+			config: map[string]interface{}{},
+			valid:  false,
+		},
+		{
+			name: "missing required key",
+			config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"owner": "example",
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "required key with different casing",
+			config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"Environment": "prod",
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "required key",
+			config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"environment": "prod",
+				},
+			},
+			valid: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(v.config), nil)
+		if v.valid != (err == nil) {
+			t.Fatalf("expected valid to be %t but got %+v", v.valid, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zones
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DiffSuppressFunc suppresses the diff for a List of Zones when the old and new values contain the same
+// Zones, regardless of their ordering - allowing a TypeList to be used with set semantics.
+//
+// NOTE: this is called for each element of the List (e.g. `zones.0` and `zones.#`), so the entire List
+// is compared rather than the individual element.
+func DiffSuppressFunc(k, _, _ string, d *schema.ResourceData) bool {
+	key := k
+	if idx := strings.LastIndex(k, "."); idx != -1 {
+		key = k[:idx]
+	}
+
+	oldRaw, newRaw := d.GetChange(key)
+	oldZones, ok := oldRaw.([]interface{})
+	if !ok {
+		return false
+	}
+	newZones, ok := newRaw.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return Zones(ExpandUntyped(oldZones)).Equal(ExpandUntyped(newZones))
+}
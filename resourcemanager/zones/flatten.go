@@ -3,21 +3,21 @@
 
 package zones
 
+// Flatten returns the Zones sorted and with any duplicates removed, since the API can return these in any order
 func Flatten(input *Schema) []string {
-	out := make([]string, 0)
-
-	if input != nil {
-		out = append(out, *input...)
+	if input == nil {
+		return make([]string, 0)
 	}
 
-	return out
+	return normalize(*input)
 }
 
+// FlattenUntyped returns the Zones sorted and with any duplicates removed, since the API can return these in any order
 func FlattenUntyped(input *[]string) []interface{} {
 	out := make([]interface{}, 0)
 
 	if input != nil {
-		for _, v := range *input {
+		for _, v := range normalize(*input) {
 			out = append(out, v)
 		}
 	}
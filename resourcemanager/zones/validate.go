@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zones
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Validator validates that Zones are available within a Location, using the Location metadata
+// from a location.Catalogue.
+//
+// An error is returned when the Catalogue knows that the Zone isn't available within the Location. Since
+// the embedded location.Catalogue doesn't contain every Location, unknown Locations are skipped unless
+// the Validator is authoritative (see NewAuthoritativeValidator) - in line with the Edge Zone Validator.
+type Validator struct {
+	lookup func(input string) (*location.Region, bool)
+
+	// authoritative specifies whether the Catalogue contains every Location, in which case unknown
+	// Locations are an error rather than being skipped
+	authoritative bool
+}
+
+// NewValidator returns a Validator using the embedded location.Catalogue for the Cloud `cloud`
+func NewValidator(cloud location.Cloud) Validator {
+	return Validator{
+		lookup: func(input string) (*location.Region, bool) {
+			return location.LookupRegion(cloud, input)
+		},
+	}
+}
+
+// NewValidatorFromCatalogue returns a Validator using the specified location.Catalogue
+func NewValidatorFromCatalogue(catalogue location.Catalogue) Validator {
+	return Validator{
+		lookup: catalogue.Region,
+	}
+}
+
+// NewAuthoritativeValidator returns a Validator using the specified location.Catalogue, which is known to
+// contain every Location (for example when it's been built from the Locations returned from the Resource
+// Manager API for the current Subscription) - as such Locations which aren't in the Catalogue are an error
+func NewAuthoritativeValidator(catalogue location.Catalogue) Validator {
+	return Validator{
+		lookup:        catalogue.Region,
+		authoritative: true,
+	}
+}
+
+// Validate returns an error if the Zone `zone` isn't available within the Location `loc`.
+//
+// NOTE: unless this Validator is authoritative, this is best-effort - when the Location isn't
+// known no error is returned, since the Zones can't be validated.
+func (v Validator) Validate(zone, loc string) error {
+	if v.lookup == nil {
+		return nil
+	}
+
+	region, ok := v.lookup(loc)
+	if !ok {
+		if v.authoritative {
+			return fmt.Errorf("the Location %q was not found, so the Zone %q cannot be validated", location.NormalizeCanonical(loc), zone)
+		}
+		return nil
+	}
+
+	if !region.SupportsAvailabilityZones || region.PhysicalZoneCount == 0 {
+		return fmt.Errorf("the Location %q does not support Availability Zones, so the Zone %q cannot be used", region.Name, zone)
+	}
+
+	number, err := strconv.Atoi(zone)
+	if err != nil || number < 1 || number > region.PhysicalZoneCount {
+		return fmt.Errorf("the Zone %q is not available in the Location %q, expected a Zone between 1 and %d", zone, region.Name, region.PhysicalZoneCount)
+	}
+
+	return nil
+}
+
+// CustomizeDiff returns a CustomizeDiffFunc which validates the Zone(s) in the field `zonesKey` against the
+// Location in the field `locationKey`. The Zones field can either be a single Zone (a String) or multiple
+// Zones (a Set or List of Strings). Validation is skipped when either value isn't yet known.
+func (v Validator) CustomizeDiff(zonesKey, locationKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(zonesKey) || !d.NewValueKnown(locationKey) {
+			return nil
+		}
+
+		loc, ok := d.Get(locationKey).(string)
+		if !ok || loc == "" {
+			return nil
+		}
+
+		zones := make([]string, 0)
+		switch raw := d.Get(zonesKey).(type) {
+		case string:
+			if raw != "" {
+				zones = append(zones, raw)
+			}
+		case *schema.Set:
+			zones = append(zones, ExpandUntyped(raw.List())...)
+		case []interface{}:
+			zones = append(zones, ExpandUntyped(raw)...)
+		}
+
+		errs := make([]error, 0)
+		for _, zone := range zones {
+			if err := v.Validate(zone, loc); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %+v", zonesKey, err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zones
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func fixtureCatalogue(t *testing.T) location.Catalogue {
+	contents, err := os.ReadFile("testdata/catalogue.json")
+	if err != nil {
+		t.Fatalf("reading fixture: %+v", err)
+	}
+
+	var catalogue location.Catalogue
+	if err := json.Unmarshal(contents, &catalogue); err != nil {
+		t.Fatalf("unmarshaling fixture: %+v", err)
+	}
+
+	return catalogue
+}
+
+func fixtureValidator(t *testing.T) Validator {
+	return NewValidatorFromCatalogue(fixtureCatalogue(t))
+}
+
+func TestValidatorValidate(t *testing.T) {
+	validator := fixtureValidator(t)
+
+	testData := []struct {
+		zone     string
+		location string
+		valid    bool
+	}{
+		{
+			zone:     "1",
+			location: "threezones",
+			valid:    true,
+		},
+		{
+			zone:     "3",
+			location: "Three Zones",
+			valid:    true,
+		},
+		{
+			zone:     "4",
+			location: "threezones",
+			valid:    false,
+		},
+		{
+			zone:     "0",
+			location: "threezones",
+			valid:    false,
+		},
+		{
+			zone:     "zone1",
+			location: "threezones",
+			valid:    false,
+		},
+		{
+			zone:     "3",
+			location: "twozones",
+			valid:    false,
+		},
+		{
+			zone:     "1",
+			location: "nozones",
+			valid:    false,
+		},
+		{
+			// unknown locations can't be validated
+			zone:     "7",
+			location: "unknown",
+			valid:    true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing Zone %q in %q", v.zone, v.location)
+
+		err := validator.Validate(v.zone, v.location)
+		if v.valid != (err == nil) {
+			t.Fatalf("expected valid to be %t but got %+v", v.valid, err)
+		}
+	}
+}
+
+func TestValidatorCustomizeDiff(t *testing.T) {
+	resource := zonesResource(fixtureValidator(t))
+
+	testData := []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{
+			config: map[string]interface{}{
+				"location": "threezones",
+				"zones":    []interface{}{"1", "2", "3"},
+			},
+			valid: true,
+		},
+		{
+			config: map[string]interface{}{
+				"location": "twozones",
+				"zones":    []interface{}{"1", "3"},
+			},
+			valid: false,
+		},
+		{
+			config: map[string]interface{}{
+				"location": "threezones",
+				"zone":     "2",
+			},
+			valid: true,
+		},
+		{
+			config: map[string]interface{}{
+				"location": "nozones",
+				"zone":     "1",
+			},
+			valid: false,
+		},
+		{
+			config: map[string]interface{}{
+				"location": "nozones",
+			},
+			valid: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.config)
+
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(v.config), nil)
+		if v.valid != (err == nil) {
+			t.Fatalf("expected valid to be %t but got %+v", v.valid, err)
+		}
+	}
+}
+
+func TestValidatorAuthoritative(t *testing.T) {
+	validator := NewAuthoritativeValidator(fixtureCatalogue(t))
+
+	if err := validator.Validate("1", "threezones"); err != nil {
+		t.Fatalf("expected Zone 1 to be valid in a Location with 3 Zones but got %+v", err)
+	}
+	if err := validator.Validate("3", "twozones"); err == nil {
+		t.Fatalf("expected an error for Zone 3 in a Location with 2 Zones")
+	}
+
+	// the Catalogue contains every Location, so an unknown Location is an error
+	if err := validator.Validate("1", "unknown"); err == nil {
+		t.Fatalf("expected an error for an unknown Location")
+	}
+}
+
+func TestValidatorEmbeddedCatalogue(t *testing.T) {
+	resource := zonesResource(NewValidator(location.CloudPublic))
+
+	testData := []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{
+			config: map[string]interface{}{
+				"location": "West Europe",
+				"zones":    []interface{}{"1", "2", "3"},
+			},
+			valid: true,
+		},
+		{
+			config: map[string]interface{}{
+				"location": "westeurope",
+				"zones":    []interface{}{"4"},
+			},
+			valid: false,
+		},
+		{
+			config: map[string]interface{}{
+				"location": "westcentralus",
+				"zone":     "1",
+			},
+			valid: false,
+		},
+		{
+			// the embedded Catalogue is scoped to the Cloud, so Locations in other Clouds are unknown and skipped
+			config: map[string]interface{}{
+				"location": "chinanorth3",
+				"zone":     "7",
+			},
+			valid: true,
+		},
+		{
+			config: map[string]interface{}{
+				"location": "atlantis",
+				"zone":     "7",
+			},
+			valid: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.config)
+
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(v.config), nil)
+		if v.valid != (err == nil) {
+			t.Fatalf("expected valid to be %t but got %+v", v.valid, err)
+		}
+	}
+}
+
+func zonesResource(validator Validator) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		CustomizeDiff: schema.CustomizeDiffFunc(func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := validator.CustomizeDiff("zone", "location")(ctx, d, meta); err != nil {
+				return err
+			}
+			return validator.CustomizeDiff("zones", "location")(ctx, d, meta)
+		}),
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zones
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Zones is a set of Availability Zones - where the ordering and any duplicate Zones are insignificant
+type Zones []string
+
+var _ json.Marshaler = Zones{}
+
+var _ json.Unmarshaler = &Zones{}
+
+// Contains returns whether the Zone `zone` is within these Zones
+func (z Zones) Contains(zone string) bool {
+	for _, v := range z {
+		if v == zone {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns whether these Zones contain the same Zones as `other`, regardless of their ordering,
+// any duplicates or whether either is nil
+func (z Zones) Equal(other Zones) bool {
+	a := z.Sorted()
+	b := other.Sorted()
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Sorted returns these Zones sorted and with any duplicates removed
+func (z Zones) Sorted() Zones {
+	return Zones(normalize(z))
+}
+
+func (z Zones) MarshalJSON() ([]byte, error) {
+	return json.Marshal(normalize(z))
+}
+
+func (z *Zones) UnmarshalJSON(input []byte) error {
+	// the API can return either `null` or `[]` when there are no Zones, which we treat the same
+	var decoded []string
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		return err
+	}
+
+	*z = normalize(decoded)
+	return nil
+}
+
+// normalize returns the Zones sorted and with any duplicates removed, this always returns a non-nil slice
+func normalize(input []string) []string {
+	seen := make(map[string]struct{}, len(input))
+	out := make([]string, 0, len(input))
+	for _, v := range input {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+
+	sort.Strings(out)
+	return out
+}
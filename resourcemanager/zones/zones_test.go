@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2018, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zones
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestZonesEqual(t *testing.T) {
+	testData := []struct {
+		first    Zones
+		second   Zones
+		expected bool
+	}{
+		{
+			first:    nil,
+			second:   Zones{},
+			expected: true,
+		},
+		{
+			first:    Zones{"1", "2", "3"},
+			second:   Zones{"3", "1", "2"},
+			expected: true,
+		},
+		{
+			first:    Zones{"1", "1", "2"},
+			second:   Zones{"2", "1"},
+			expected: true,
+		},
+		{
+			first:    Zones{"1", "2"},
+			second:   Zones{"1", "3"},
+			expected: false,
+		},
+		{
+			first:    Zones{"1"},
+			second:   nil,
+			expected: false,
+		},
+	}
+	for _, v := range testData {
+		if actual := v.first.Equal(v.second); actual != v.expected {
+			t.Fatalf("expected %+v equal to %+v to be %t but got %t", v.first, v.second, v.expected, actual)
+		}
+	}
+
+	if !(Zones{"2", "1"}).Contains("1") {
+		t.Fatalf("expected the zones to contain `1`")
+	}
+	if (Zones{"2", "1"}).Contains("3") {
+		t.Fatalf("expected the zones not to contain `3`")
+	}
+}
+
+func TestZonesJSON(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected Zones
+	}{
+		{
+			input:    `{"zones": null}`,
+			expected: Zones{},
+		},
+		{
+			input:    `{"zones": []}`,
+			expected: Zones{},
+		},
+		{
+			input:    `{}`,
+			expected: nil,
+		},
+		{
+			input:    `{"zones": ["3", "1", "1"]}`,
+			expected: Zones{"1", "3"},
+		},
+	}
+	for _, v := range testData {
+		var decoded struct {
+			Zones Zones `json:"zones"`
+		}
+		if err := json.Unmarshal([]byte(v.input), &decoded); err != nil {
+			t.Fatalf("unmarshaling %s: %+v", v.input, err)
+		}
+		if !decoded.Zones.Equal(v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, decoded.Zones)
+		}
+	}
+
+	encoded, err := json.Marshal(Zones{"2", "1"})
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	if string(encoded) != `["1","2"]` {
+		t.Fatalf("expected %s but got %s", `["1","2"]`, string(encoded))
+	}
+
+	encoded, err = json.Marshal(Zones(nil))
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	if string(encoded) != `[]` {
+		t.Fatalf("expected %s but got %s", `[]`, string(encoded))
+	}
+}
+
+func TestFlattenSortsAndDeduplicates(t *testing.T) {
+	input := Schema{"3", "1", "3", "2"}
+
+	expected := []string{"1", "2", "3"}
+	if actual := Flatten(&input); !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	expectedUntyped := []interface{}{"1", "2", "3"}
+	if actual := FlattenUntyped(&input); !reflect.DeepEqual(expectedUntyped, actual) {
+		t.Fatalf("expected %+v but got %+v", expectedUntyped, actual)
+	}
+
+	if actual := Flatten(nil); actual == nil || len(actual) != 0 {
+		t.Fatalf("expected an empty list when flattening nil but got %+v", actual)
+	}
+}
+
+func TestDiffSuppressFunc(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zones": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: DiffSuppressFunc,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":      "example",
+			"zones.#": "2",
+			"zones.0": "2",
+			"zones.1": "1",
+		},
+	}
+
+	diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zones": []interface{}{"1", "2"},
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no diff when the zones are reordered but got %+v", diff)
+	}
+
+	diff, err = resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zones": []interface{}{"1", "3"},
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if diff == nil || diff.Empty() {
+		t.Fatalf("expected a diff when the zones change")
+	}
+}